@@ -0,0 +1,4 @@
+// Package protobuf gRPC接口定义 生成的代码与proto文件位于同一目录
+package protobuf
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative user/v1/user.proto team/v1/team.proto token/v1/token.proto
@@ -65,6 +65,7 @@ CREATE TABLE users
     updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     last_login_at  TIMESTAMP WITH TIME ZONE,
     status         VARCHAR(20)         NOT NULL DEFAULT 'active',
+    locale         VARCHAR(10)         NOT NULL DEFAULT 'zh', -- 邮件等通知使用的语言
     -- OAuth ID 唯一约束
     UNIQUE (github_id),
     UNIQUE (google_id),
@@ -185,6 +186,99 @@ CREATE TABLE usage_stats
     CONSTRAINT valid_period CHECK (period_end IS NULL OR period_end >= period_start)
 );
 
+-- Webhook 端点表（按 owner_id 分片）
+CREATE TABLE webhook_endpoints
+(
+    webhook_id UUID DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL, -- 分片键：团队所有者
+    team_id UUID NOT NULL,
+    url         VARCHAR(500) NOT NULL,
+    secret      VARCHAR(128) NOT NULL,             -- HMAC 签名密钥
+    event_types TEXT[]       NOT NULL DEFAULT '{}', -- 订阅的事件类型
+    description TEXT,
+    created_by UUID NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    status      VARCHAR(20)  NOT NULL DEFAULT 'active',
+    PRIMARY KEY (owner_id, webhook_id),
+    -- 状态约束
+    CONSTRAINT valid_webhook_status CHECK (status IN ('active', 'disabled'))
+);
+
+-- Webhook 投递记录表（按 owner_id 分片）
+CREATE TABLE webhook_deliveries
+(
+    delivery_id UUID DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL, -- 分片键：团队所有者
+    webhook_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    event_id UUID NOT NULL, -- 同一事件的重新投递共用
+    event_type    VARCHAR(100) NOT NULL,
+    payload       JSONB        NOT NULL,
+    status        VARCHAR(20)  NOT NULL DEFAULT 'pending',
+    attempts      INTEGER      NOT NULL DEFAULT 0,
+    response_code INTEGER,
+    response_body TEXT,
+    error         TEXT,
+    duration_ms   INTEGER,
+    is_redelivery BOOLEAN      NOT NULL DEFAULT false,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at  TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (owner_id, delivery_id),
+    -- 状态约束
+    CONSTRAINT valid_delivery_status CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+-- 审计日志表（按 owner_id 分片，只追加不修改）
+CREATE TABLE audit_logs
+(
+    audit_id UUID DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL, -- 分片键：团队所有者，个人操作为用户本人
+    team_id UUID,           -- 个人操作为空
+    actor_id UUID,          -- 操作者，系统操作为空
+    action      VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50)  NOT NULL,
+    target_id   VARCHAR(100),
+    ip          VARCHAR(64),
+    user_agent  VARCHAR(500),
+    changes     JSONB,      -- 字段变更 {"field": {"before": x, "after": y}}
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (owner_id, audit_id)
+);
+
+-- 邮件投递记录表（按 message_id 分片）
+CREATE TABLE email_deliveries
+(
+    message_id          VARCHAR(255) NOT NULL, -- 分片键：邮件 Message-ID
+    provider            VARCHAR(50)  NOT NULL, -- 实际投递的后端 smtp/api/log/file
+    provider_message_id VARCHAR(255),          -- 服务商返回的消息 ID
+    recipient           VARCHAR(255) NOT NULL,
+    subject             VARCHAR(500) NOT NULL,
+    template            VARCHAR(100),
+    status              VARCHAR(20)  NOT NULL DEFAULT 'sent',
+    error               TEXT,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id),
+    -- 状态约束
+    CONSTRAINT valid_email_status CHECK (status IN ('sent', 'failed', 'delivered', 'bounced', 'complained'))
+);
+
+-- 邮件事件表（退信、投诉、送达回执，与 email_deliveries 同位分片）
+CREATE TABLE email_events
+(
+    event_id UUID DEFAULT gen_random_uuid(),
+    message_id  VARCHAR(255) NOT NULL,
+    event_type  VARCHAR(20)  NOT NULL,
+    reason      TEXT,
+    payload     JSONB,        -- 服务商回调原始内容
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, event_id),
+    -- 类型约束
+    CONSTRAINT valid_email_event_type CHECK (event_type IN ('delivered', 'bounced', 'complained'))
+);
+
 -- 设置引用表
 SELECT create_reference_table('plans');
 SELECT create_reference_table('users');
@@ -196,6 +290,11 @@ SELECT create_distributed_table('team_members', 'owner_id');
 SELECT create_distributed_table('projects', 'owner_id');
 SELECT create_distributed_table('project_members', 'owner_id');
 SELECT create_distributed_table('usage_stats', 'user_id');
+SELECT create_distributed_table('webhook_endpoints', 'owner_id');
+SELECT create_distributed_table('webhook_deliveries', 'owner_id');
+SELECT create_distributed_table('audit_logs', 'owner_id');
+SELECT create_distributed_table('email_deliveries', 'message_id');
+SELECT create_distributed_table('email_events', 'message_id', colocate_with => 'email_deliveries');
 
 -- 引用表索引
 CREATE INDEX idx_users_email ON users (email);
@@ -225,6 +324,35 @@ CREATE INDEX idx_proj_members_status ON project_members (status);
 
 CREATE INDEX idx_usage_stats_metric_period ON usage_stats (metric_name, period_start);
 
+CREATE INDEX idx_webhook_endpoints_team_id ON webhook_endpoints (team_id);
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id, created_at DESC);
+
+CREATE INDEX idx_audit_logs_team_created ON audit_logs (team_id, created_at DESC) WHERE team_id IS NOT NULL;
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX idx_audit_logs_action ON audit_logs (action);
+
+-- 审计日志只追加 在数据库层拒绝更新和删除 应用以超级用户连接时REVOKE不生效
+CREATE FUNCTION audit_logs_append_only() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only, % is not allowed', TG_OP;
+END;
+$$;
+
+-- 触发器函数不读写其他分片 可以安全地在分布式表上使用
+SET citus.enable_unsafe_triggers TO on;
+CREATE TRIGGER audit_logs_no_update
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+CREATE TRIGGER audit_logs_no_truncate
+    BEFORE TRUNCATE ON audit_logs
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
+RESET citus.enable_unsafe_triggers;
+
+CREATE INDEX idx_email_deliveries_recipient ON email_deliveries (recipient, created_at DESC);
+CREATE INDEX idx_email_deliveries_status ON email_deliveries (status);
+
 -- 插入默认计划数据
 INSERT INTO plans (plan_type,
                    name,
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/audit"
+	"sass-scaffold/internal/common/email"
+	"sass-scaffold/internal/common/health"
+	"sass-scaffold/internal/common/logger/logadmin"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/ratelimit"
+	"sass-scaffold/internal/introspection"
+	"sass-scaffold/internal/maillog"
+	"sass-scaffold/internal/user"
+	"sass-scaffold/internal/webhook"
+)
+
+// AuthRouter 依赖认证中间件的模块路由
+type AuthRouter func(r *gin.RouterGroup, authMiddleware *auth.Middleware) func()
+
+// Router 不使用用户认证的模块路由
+type Router func(r *gin.RouterGroup) func()
+
+// Modules 各业务模块的路由注册函数
+// 服务启动时传入wire生成的InitV1 文档工具传入只注册路由的RegisterV1
+type Modules struct {
+	User          AuthRouter
+	Webhook       AuthRouter
+	Audit         AuthRouter
+	Introspection AuthRouter
+	Maillog       Router
+}
+
+// InitModules 连接数据库等依赖 用于服务启动
+func InitModules() Modules {
+	return Modules{
+		User:          user.InitV1,
+		Webhook:       webhook.InitV1,
+		Audit:         audit.InitV1,
+		Introspection: introspection.InitV1,
+		Maillog:       maillog.InitV1,
+	}
+}
+
+// RouteOnlyModules handler为nil 只用于生成接口文档 不能处理请求
+func RouteOnlyModules() Modules {
+	return Modules{
+		User: func(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+			return user.RegisterV1(r, nil, authMiddleware)
+		},
+		Webhook: func(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+			return webhook.RegisterV1(r, nil, authMiddleware)
+		},
+		Audit: func(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+			return audit.RegisterV1(r, nil, authMiddleware)
+		},
+		Introspection: func(r *gin.RouterGroup, _ *auth.Middleware) func() {
+			return introspection.RegisterV1(r, nil)
+		},
+		Maillog: func(r *gin.RouterGroup) func() {
+			return maillog.RegisterV1(r, nil)
+		},
+	}
+}
+
+// RegisterRoutes 注册/api下的全部路由 mian.go与tool/openapi共用 保证接口文档与实际路由一致
+func RegisterRoutes(r *gin.RouterGroup, authMiddleware *auth.Middleware, m Modules) {
+	m.User(r, authMiddleware)
+	m.Webhook(r, authMiddleware)
+	m.Audit(r, authMiddleware)
+	m.Introspection(r, authMiddleware)
+	m.Maillog(r)
+
+	// 开发模式的邮件预览无需登录 携带令牌时按用户限流 否则按IP限流
+	email.RegisterPreview(r.Group("", authMiddleware.Optional(), ratelimit.PerUser()))
+
+	admin := r.Group("/admin", authMiddleware.Validate(), auth.RequireAdmin())
+	logadmin.RegisterRoutes(admin)
+	health.RegisterAdminRoutes(admin)
+}
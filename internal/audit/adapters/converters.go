@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+
+	"sass-scaffold/internal/audit/domain"
+	"sass-scaffold/internal/common/orm"
+)
+
+func domainEntryToORM(entry *domain.Entry) (*orm.AuditLog, error) {
+	if entry == nil {
+		return nil, nil
+	}
+
+	ormEntry := &orm.AuditLog{
+		AuditID:    entry.ID,
+		OwnerID:    entry.OwnerID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		CreatedAt:  entry.CreatedAt,
+	}
+
+	if entry.TeamID != "" {
+		ormEntry.TeamID = null.StringFrom(entry.TeamID)
+	}
+
+	if entry.ActorID != "" {
+		ormEntry.ActorID = null.StringFrom(entry.ActorID)
+	}
+
+	if entry.TargetID != "" {
+		ormEntry.TargetID = null.StringFrom(entry.TargetID)
+	}
+
+	if entry.IP != "" {
+		ormEntry.IP = null.StringFrom(entry.IP)
+	}
+
+	if entry.UserAgent != "" {
+		ormEntry.UserAgent = null.StringFrom(entry.UserAgent)
+	}
+
+	if len(entry.Changes) > 0 {
+		changes, err := json.Marshal(entry.Changes)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ormEntry.Changes = null.JSONFrom(changes)
+	}
+
+	return ormEntry, nil
+}
+
+func ormEntryToDomain(ormEntry *orm.AuditLog) *domain.Entry {
+	if ormEntry == nil {
+		return nil
+	}
+
+	entry := &domain.Entry{
+		ID:         ormEntry.AuditID,
+		OwnerID:    ormEntry.OwnerID,
+		Action:     ormEntry.Action,
+		TargetType: ormEntry.TargetType,
+		CreatedAt:  ormEntry.CreatedAt,
+	}
+
+	if ormEntry.TeamID.Valid {
+		entry.TeamID = ormEntry.TeamID.String
+	}
+
+	if ormEntry.ActorID.Valid {
+		entry.ActorID = ormEntry.ActorID.String
+	}
+
+	if ormEntry.TargetID.Valid {
+		entry.TargetID = ormEntry.TargetID.String
+	}
+
+	if ormEntry.IP.Valid {
+		entry.IP = ormEntry.IP.String
+	}
+
+	if ormEntry.UserAgent.Valid {
+		entry.UserAgent = ormEntry.UserAgent.String
+	}
+
+	if ormEntry.Changes.Valid {
+		// 历史数据格式异常时忽略变更内容 不影响列表展示
+		_ = ormEntry.Changes.Unmarshal(&entry.Changes)
+	}
+
+	return entry
+}
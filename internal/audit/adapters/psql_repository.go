@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"sass-scaffold/internal/audit/domain"
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/common/teamaccess"
+	"sass-scaffold/internal/common/utils"
+)
+
+type PSQLAuditRepository struct {
+	db *sql.DB
+}
+
+func NewPSQLAuditRepository(db *sql.DB) domain.AuditRepository {
+	return &PSQLAuditRepository{
+		db: db,
+	}
+}
+
+func (r *PSQLAuditRepository) FindTeamAccess(ctx context.Context, teamID, userID string) (*domain.TeamAccess, error) {
+	return teamaccess.Find(ctx, r.db, teamID, userID)
+}
+
+// Create 审计日志只追加 不提供更新和删除
+func (r *PSQLAuditRepository) Create(ctx context.Context, entry *domain.Entry) error {
+	ormEntry, err := domainEntryToORM(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := ormEntry.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+	return nil
+}
+
+func (r *PSQLAuditRepository) Find(ctx context.Context, filter *domain.Filter) ([]*domain.Entry, int64, error) {
+	offset, err := utils.ComputeOffset(filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	where := []qm.QueryMod{
+		orm.AuditLogWhere.OwnerID.EQ(filter.OwnerID),
+	}
+	if filter.TeamID != "" {
+		where = append(where, orm.AuditLogWhere.TeamID.EQ(null.StringFrom(filter.TeamID)))
+	}
+	if filter.ActorID != "" {
+		where = append(where, orm.AuditLogWhere.ActorID.EQ(null.StringFrom(filter.ActorID)))
+	}
+	if filter.Action != "" {
+		where = append(where, orm.AuditLogWhere.Action.EQ(filter.Action))
+	}
+	if filter.TargetType != "" {
+		where = append(where, orm.AuditLogWhere.TargetType.EQ(filter.TargetType))
+	}
+	if filter.From != nil {
+		where = append(where, orm.AuditLogWhere.CreatedAt.GTE(*filter.From))
+	}
+	if filter.To != nil {
+		where = append(where, orm.AuditLogWhere.CreatedAt.LT(*filter.To))
+	}
+
+	total, err := orm.AuditLogs(where...).Count(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %w", err)
+	}
+
+	ormEntries, err := orm.AuditLogs(append(where,
+		qm.OrderBy(orm.AuditLogColumns.CreatedAt+" DESC"),
+		qm.Limit(filter.PageSize),
+		qm.Offset(offset),
+	)...).All(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %w", err)
+	}
+
+	entries := make([]*domain.Entry, 0, len(ormEntries))
+	for _, e := range ormEntries {
+		entries = append(entries, ormEntryToDomain(e))
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/teamaccess"
+)
+
+// 审计目标类型
+const (
+	TargetUser       = "user"
+	TargetTeam       = "team"
+	TargetTeamMember = "team_member"
+)
+
+// 审计日志（只追加）
+type Entry struct {
+	ID         string                     `json:"id"`
+	OwnerID    string                     `json:"owner_id"`
+	TeamID     string                     `json:"team_id,omitempty"`
+	ActorID    string                     `json:"actor_id,omitempty"`
+	Action     string                     `json:"action"`
+	TargetType string                     `json:"target_type"`
+	TargetID   string                     `json:"target_id,omitempty"`
+	IP         string                     `json:"ip,omitempty"`
+	UserAgent  string                     `json:"user_agent,omitempty"`
+	Changes    map[string]eventbus.Change `json:"changes,omitempty"`
+	CreatedAt  time.Time                  `json:"created_at"`
+}
+
+// 查询条件（值对象）
+type Filter struct {
+	OwnerID    string
+	TeamID     string
+	ActorID    string
+	Action     string
+	TargetType string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PageSize   int
+}
+
+// 团队访问信息（值对象）
+type TeamAccess = teamaccess.Access
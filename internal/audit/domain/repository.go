@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+type AuditRepository interface {
+	FindTeamAccess(ctx context.Context, teamID, userID string) (*TeamAccess, error)
+
+	Create(ctx context.Context, entry *Entry) error
+	Find(ctx context.Context, filter *Filter) ([]*Entry, int64, error)
+}
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type AuditService interface {
+	Record(ctx context.Context, entry *Entry) error
+	ListTeamEntries(ctx context.Context, userID, teamID string, filter *Filter) ([]*Entry, int64, error)
+}
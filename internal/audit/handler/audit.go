@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/reskit/response"
+	"sass-scaffold/internal/common/utils"
+)
+
+func (h *HttpHandler) ListTeamAudit(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(AuditListRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	entries, total, err := h.service.ListTeamEntries(ctx.Request.Context(), userID, ctx.Param("id"), HTTPAuditListToDomain(req))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	pages, err := utils.ComputePages(total, req.PageSize, req.Page)
+	if err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	list := make([]*AuditResponse, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, DomainEntryToResponse(e))
+	}
+
+	response.Success(ctx, &AuditListResponse{
+		List:  list,
+		Total: total,
+		Pages: pages,
+	})
+}
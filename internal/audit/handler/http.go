@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/audit/domain"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+type HttpHandler struct {
+	service domain.AuditService
+}
+
+func NewHttpHandler(service domain.AuditService) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+	}
+}
+
+// 认证中间件写入的用户ID
+func (h *HttpHandler) getUserID(ctx *gin.Context) (string, error) {
+	user, ok := auth.UserFromContext(ctx.Request.Context())
+	if !ok {
+		return "", codes.ErrUnauthorized
+	}
+	return user.ID, nil
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"time"
+
+	"sass-scaffold/internal/audit/domain"
+	"sass-scaffold/internal/common/eventbus"
+)
+
+// HTTP 请求/响应模型
+type AuditListRequest struct {
+	Action     string     `form:"action" binding:"max=100"`
+	ActorID    string     `form:"actor_id" binding:"omitempty,uuid"`
+	TargetType string     `form:"target_type" binding:"max=50"`
+	From       *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page       int        `form:"page,default=1" binding:"min=1"`
+	PageSize   int        `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+type AuditResponse struct {
+	ID         string                     `json:"id"`
+	TeamID     string                     `json:"team_id,omitempty"`
+	ActorID    string                     `json:"actor_id,omitempty"`
+	Action     string                     `json:"action"`
+	TargetType string                     `json:"target_type"`
+	TargetID   string                     `json:"target_id,omitempty"`
+	IP         string                     `json:"ip,omitempty"`
+	UserAgent  string                     `json:"user_agent,omitempty"`
+	Changes    map[string]eventbus.Change `json:"changes,omitempty"`
+	CreatedAt  time.Time                  `json:"created_at"`
+}
+
+type AuditListResponse struct {
+	List  []*AuditResponse `json:"list"`
+	Total int64            `json:"total"`
+	Pages int              `json:"pages"`
+}
+
+// 转换函数
+func DomainEntryToResponse(entry *domain.Entry) *AuditResponse {
+	if entry == nil {
+		return nil
+	}
+
+	return &AuditResponse{
+		ID:         entry.ID,
+		TeamID:     entry.TeamID,
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		IP:         entry.IP,
+		UserAgent:  entry.UserAgent,
+		Changes:    entry.Changes,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func HTTPAuditListToDomain(req *AuditListRequest) *domain.Filter {
+	return &domain.Filter{
+		ActorID:    req.ActorID,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		From:       req.From,
+		To:         req.To,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}
+}
@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/audit/handler"
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// 接口文档 修改router_v1.go中的路由时同步修改
+func init() {
+	openapi.Register(openapi.Operation{
+		Method:   http.MethodGet,
+		Path:     "/v1/teams/:id/audit",
+		Summary:  "团队审计日志",
+		Tags:     []string{"audit"},
+		Auth:     true,
+		Query:    handler.AuditListRequest{},
+		Response: handler.AuditListResponse{},
+		Errors:   []codes.ErrCode{codes.ErrTeamNotFound, codes.ErrTeamPermissionDenied, codes.ErrRateLimitExceeded},
+	})
+}
@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/audit/handler"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/ratelimit"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler, authMiddleware *auth.Middleware) func() {
+	g := r.Group("/v1/teams/:id/audit")
+	g.Use(authMiddleware.Validate(), ratelimit.PerUser())
+	{
+		g.GET("", handler.ListTeamAudit)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"sass-scaffold/internal/audit/domain"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+type auditService struct {
+	repo domain.AuditRepository
+}
+
+func NewAuditService(repo domain.AuditRepository, bus *eventbus.Bus) domain.AuditService {
+	s := &auditService{
+		repo: repo,
+	}
+	s.subscribe(bus)
+	return s
+}
+
+// 订阅需要留痕的领域事件 事件名即审计动作
+func (s *auditService) subscribe(bus *eventbus.Bus) {
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.UserRegistered) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.UserID,
+			ActorID:    e.UserID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetUser,
+			TargetID:   e.UserID,
+			Changes: map[string]eventbus.Change{
+				"provider": {After: e.Provider},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.UserLoggedIn) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.UserID,
+			ActorID:    e.UserID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetUser,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			Changes: map[string]eventbus.Change{
+				"provider": {After: e.Provider},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.TokenRefreshed) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.UserID,
+			ActorID:    e.UserID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetUser,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			CreatedAt:  e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.ProfileUpdated) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.UserID,
+			ActorID:    e.UserID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetUser,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			Changes:    e.Changes,
+			CreatedAt:  e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.TeamCreated) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.OwnerID,
+			TeamID:     e.TeamID,
+			ActorID:    e.OwnerID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetTeam,
+			TargetID:   e.TeamID,
+			Changes: map[string]eventbus.Change{
+				"name": {After: e.Name},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberInvited) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.OwnerID,
+			TeamID:     e.TeamID,
+			ActorID:    e.InvitedBy,
+			Action:     e.EventName(),
+			TargetType: domain.TargetTeamMember,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			Changes: map[string]eventbus.Change{
+				"role": {After: e.Role},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberJoined) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.OwnerID,
+			TeamID:     e.TeamID,
+			ActorID:    e.UserID,
+			Action:     e.EventName(),
+			TargetType: domain.TargetTeamMember,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			Changes: map[string]eventbus.Change{
+				"role": {After: e.Role},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberRoleChanged) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.OwnerID,
+			TeamID:     e.TeamID,
+			ActorID:    e.ChangedBy,
+			Action:     e.EventName(),
+			TargetType: domain.TargetTeamMember,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			Changes: map[string]eventbus.Change{
+				"role": {Before: e.OldRole, After: e.NewRole},
+			},
+			CreatedAt: e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberRemoved) error {
+		return s.Record(ctx, &domain.Entry{
+			OwnerID:    e.OwnerID,
+			TeamID:     e.TeamID,
+			ActorID:    e.RemovedBy,
+			Action:     e.EventName(),
+			TargetType: domain.TargetTeamMember,
+			TargetID:   e.UserID,
+			IP:         e.Meta.IP,
+			UserAgent:  e.Meta.UserAgent,
+			CreatedAt:  e.OccurredAt,
+		})
+	})
+}
+
+func (s *auditService) Record(ctx context.Context, entry *domain.Entry) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	return s.repo.Create(ctx, entry)
+}
+
+func (s *auditService) ListTeamEntries(ctx context.Context, userID, teamID string, filter *domain.Filter) ([]*domain.Entry, int64, error) {
+	access, err := s.repo.FindTeamAccess(ctx, teamID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !access.IsAdmin() {
+		return nil, 0, codes.ErrTeamPermissionDenied
+	}
+
+	filter.OwnerID = access.OwnerID
+	filter.TeamID = teamID
+	return s.repo.Find(ctx, filter)
+}
@@ -0,0 +1,27 @@
+//go:build wireinject
+// +build wireinject
+
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"sass-scaffold/internal/audit/adapters"
+	"sass-scaffold/internal/audit/handler"
+	"sass-scaffold/internal/audit/service"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
+)
+
+func InitV1(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewAuditService,
+		adapters.NewPSQLAuditRepository,
+		eventbus.GetBusInstance,
+		datastore.ProviderSet,
+	)
+	return nil
+}
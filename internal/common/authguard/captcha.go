@@ -0,0 +1,70 @@
+package authguard
+
+import (
+	"context"
+	"crypto/subtle"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"sass-scaffold/internal/common/config"
+)
+
+// 验证码实现
+const (
+	CaptchaNone = "none"
+	CaptchaStub = "stub"
+)
+
+// CaptchaVerifier 验证码校验 接入第三方服务(hCaptcha/Turnstile等)时实现该接口并调用SetCaptchaVerifier
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// NoOpCaptcha 不校验 使用时不要求客户端提交验证码
+type NoOpCaptcha struct{}
+
+func (NoOpCaptcha) Verify(context.Context, string, string) error {
+	return nil
+}
+
+// StubCaptcha 只接受固定token 用于测试与本地开发
+type StubCaptcha struct {
+	Token string
+}
+
+func (s StubCaptcha) Verify(_ context.Context, token, _ string) error {
+	if s.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.Token)) != 1 {
+		return errors.New("验证码token无效")
+	}
+	return nil
+}
+
+type verifierHolder struct {
+	CaptchaVerifier
+}
+
+var captchaVerifier atomic.Pointer[verifierHolder]
+
+// SetCaptchaVerifier 替换全局验证码校验器
+func SetCaptchaVerifier(v CaptchaVerifier) {
+	captchaVerifier.Store(&verifierHolder{v})
+}
+
+func getCaptchaVerifier() CaptchaVerifier {
+	if h := captchaVerifier.Load(); h != nil {
+		return h.CaptchaVerifier
+	}
+	return NoOpCaptcha{}
+}
+
+func newCaptchaVerifier(cfg config.AuthGuardConfig) (CaptchaVerifier, error) {
+	switch cfg.Captcha {
+	case CaptchaNone, "":
+		return NoOpCaptcha{}, nil
+	case CaptchaStub:
+		return StubCaptcha{Token: cfg.CaptchaStubToken}, nil
+	default:
+		return nil, errors.Errorf("不支持的验证码实现%s", cfg.Captcha)
+	}
+}
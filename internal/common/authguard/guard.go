@@ -0,0 +1,202 @@
+package authguard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+const (
+	redisKeyPrefix = "authguard:"
+	// 锁定次数的统计周期 超过后锁定时长从LockoutBase重新计算
+	lockoutCountTTL = 24 * time.Hour
+)
+
+var current atomic.Pointer[config.AuthGuardConfig]
+
+// Init 加载配置并创建验证码校验器 需先初始化datastore
+func Init(cfg config.AuthGuardConfig) error {
+	verifier, err := newCaptchaVerifier(cfg)
+	if err != nil {
+		return err
+	}
+	SetCaptchaVerifier(verifier)
+	current.Store(&cfg)
+	return nil
+}
+
+// SetConfig 热加载时替换阈值与锁定配置 验证码校验器需重启生效
+func SetConfig(cfg config.AuthGuardConfig) {
+	current.Store(&cfg)
+}
+
+func currentConfig() config.AuthGuardConfig {
+	if cfg := current.Load(); cfg != nil {
+		return *cfg
+	}
+	return config.AuthGuardConfig{}
+}
+
+// IPKey 按客户端IP计数 IP取自gin.ClientIP 只采信SERVER_TRUSTED_PROXIES中代理转发的地址
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// AccountKey 按账号计数 kind区分账号标识的类型 如user_id
+// id须是已验证的账号 使用客户端提交的未验证标识会让他人可以锁定任意账号
+func AccountKey(kind, id string) string {
+	return "account:" + kind + ":" + id
+}
+
+// CredentialKey 按客户端提交的凭证计数 如刷新令牌 键名中只保存哈希
+func CredentialKey(kind, credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return "credential:" + kind + ":" + hex.EncodeToString(sum[:16])
+}
+
+func failKey(key string) string     { return redisKeyPrefix + "fail:" + key }
+func lockKey(key string) string     { return redisKeyPrefix + "lock:" + key }
+func lockoutsKey(key string) string { return redisKeyPrefix + "lockouts:" + key }
+
+// Locked 任一key处于锁定状态时返回最长的剩余锁定时间
+func Locked(ctx context.Context, keys ...string) (time.Duration, error) {
+	pipe := datastore.GetRedisInstance().Pipeline()
+	cmds := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.PTTL(ctx, lockKey(key))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "查询锁定状态失败")
+	}
+
+	var remaining time.Duration
+	for _, cmd := range cmds {
+		// key不存在时返回负数
+		if d := cmd.Val(); d > remaining {
+			remaining = d
+		}
+	}
+	return remaining, nil
+}
+
+// Check 供无法完成验证码的调用方(如gRPC)使用 只检查锁定状态
+// Redis不可用时放行
+func Check(ctx context.Context, keys ...string) error {
+	if !currentConfig().Enabled {
+		return nil
+	}
+
+	remaining, err := Locked(ctx, keys...)
+	if err != nil {
+		logger.FromContext(ctx).Error("登录防护检查失败", zap.Error(err))
+		return nil
+	}
+	if remaining > 0 {
+		retryAfter := int(math.Ceil(remaining.Seconds()))
+		return codes.ErrAuthLocked.WithDetail(map[string]any{"retry_after": retryAfter})
+	}
+	return nil
+}
+
+// Failures 窗口内的最大连续失败次数
+func Failures(ctx context.Context, keys ...string) (int, error) {
+	pipe := datastore.GetRedisInstance().Pipeline()
+	cmds := make([]*redis.StringCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.Get(ctx, failKey(key))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, errors.Wrap(err, "查询失败次数失败")
+	}
+
+	var failures int
+	for _, cmd := range cmds {
+		if n, _ := cmd.Int(); n > failures {
+			failures = n
+		}
+	}
+	return failures, nil
+}
+
+// Fail 记录一次失败 达到上限后锁定 锁定时长按连续锁定次数翻倍
+func Fail(ctx context.Context, keys ...string) {
+	cfg := currentConfig()
+	if !cfg.Enabled {
+		return
+	}
+	for _, key := range keys {
+		if err := fail(ctx, cfg, key); err != nil {
+			logger.FromContext(ctx).Error("记录登录失败次数失败", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+func fail(ctx context.Context, cfg config.AuthGuardConfig, key string) error {
+	client := datastore.GetRedisInstance()
+
+	pipe := client.TxPipeline()
+	incr := pipe.Incr(ctx, failKey(key))
+	// 每次失败都顺延窗口 持续尝试不会因窗口到期而重置
+	pipe.PExpire(ctx, failKey(key), cfg.FailureWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "失败次数计数失败")
+	}
+	if cfg.MaxFailures <= 0 || incr.Val() < int64(cfg.MaxFailures) {
+		return nil
+	}
+
+	pipe = client.TxPipeline()
+	lockouts := pipe.Incr(ctx, lockoutsKey(key))
+	pipe.Expire(ctx, lockoutsKey(key), lockoutCountTTL)
+	pipe.Del(ctx, failKey(key))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "锁定次数计数失败")
+	}
+
+	duration := lockoutDuration(cfg, lockouts.Val())
+	if err := client.Set(ctx, lockKey(key), strconv.FormatInt(lockouts.Val(), 10), duration).Err(); err != nil {
+		return errors.Wrap(err, "锁定失败")
+	}
+	logger.FromContext(ctx).Warn("登录失败次数过多,已临时锁定",
+		zap.String("key", key), zap.Int64("lockouts", lockouts.Val()), zap.Duration("duration", duration))
+	return nil
+}
+
+// lockoutDuration 第n次锁定的时长 LockoutBase * 2^(n-1) 最长LockoutMax
+func lockoutDuration(cfg config.AuthGuardConfig, n int64) time.Duration {
+	d := cfg.LockoutBase
+	for i := int64(1); i < n && d < cfg.LockoutMax; i++ {
+		d *= 2
+	}
+	if cfg.LockoutMax > 0 && d > cfg.LockoutMax {
+		d = cfg.LockoutMax
+	}
+	return d
+}
+
+// Succeed 认证成功后清空连续失败次数 锁定次数在统计周期后自然过期
+func Succeed(ctx context.Context, keys ...string) {
+	if !currentConfig().Enabled {
+		return
+	}
+	failKeys := make([]string, len(keys))
+	for i, key := range keys {
+		failKeys[i] = failKey(key)
+	}
+	if err := datastore.GetRedisInstance().Del(ctx, failKeys...).Err(); err != nil {
+		logger.FromContext(ctx).Error("清空登录失败次数失败", zap.Error(err))
+	}
+}
@@ -0,0 +1,70 @@
+package authguard
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+const headerRetryAfter = "Retry-After"
+
+// Guard 认证前检查锁定状态 失败次数较多时要求验证码 返回false时已写入错误响应
+// Redis不可用时放行 由限流中间件兜底
+func Guard(c *gin.Context, captchaToken string, keys ...string) bool {
+	cfg := currentConfig()
+	if !cfg.Enabled {
+		return true
+	}
+	ctx := c.Request.Context()
+
+	remaining, err := Locked(ctx, keys...)
+	if err != nil {
+		logger.FromContext(ctx).Error("登录防护检查失败", zap.Error(err))
+		return true
+	}
+	if remaining > 0 {
+		retryAfter := int(math.Ceil(remaining.Seconds()))
+		c.Header(headerRetryAfter, strconv.Itoa(retryAfter))
+		response.Error(c, codes.ErrAuthLocked.WithDetail(map[string]any{"retry_after": retryAfter}))
+		return false
+	}
+
+	verifier := getCaptchaVerifier()
+	if _, noop := verifier.(NoOpCaptcha); noop || cfg.CaptchaAfter <= 0 {
+		return true
+	}
+	failures, err := Failures(ctx, keys...)
+	if err != nil {
+		logger.FromContext(ctx).Error("登录防护检查失败", zap.Error(err))
+		return true
+	}
+	if failures < cfg.CaptchaAfter {
+		return true
+	}
+
+	if captchaToken == "" {
+		response.Error(c, codes.ErrCaptchaRequired)
+		return false
+	}
+	if err := verifier.Verify(ctx, captchaToken, c.ClientIP()); err != nil {
+		Fail(ctx, keys...)
+		response.Error(c, codes.ErrCaptchaInvalid.WithCause(err))
+		return false
+	}
+	return true
+}
+
+// Country 请求来源的国家代码 由CDN写入请求头 未配置时为空
+func Country(c *gin.Context) string {
+	header := currentConfig().CountryHeader
+	if header == "" {
+		return ""
+	}
+	return c.GetHeader(header)
+}
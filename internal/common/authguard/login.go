@@ -0,0 +1,103 @@
+package authguard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/email"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/logger"
+)
+
+const (
+	newLoginTemplate = "new_login"
+	// 超过该时长未使用的设备与地区视为新的
+	knownLoginTTL = 180 * 24 * time.Hour
+	maxDeviceLen  = 200
+)
+
+// Recipient 提醒邮件的收件人
+type Recipient struct {
+	Email  string
+	Name   string
+	Locale string
+}
+
+// RecipientLookup 按用户ID查询收件人 由用户模块实现
+type RecipientLookup func(ctx context.Context, userID string) (Recipient, error)
+
+// SubscribeLogins 登录成功后检查是否来自新设备或新地区 是则发送提醒邮件
+func SubscribeLogins(bus *eventbus.Bus, lookup RecipientLookup) {
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.UserLoggedIn) error {
+		cfg := currentConfig()
+		if !cfg.Enabled || !cfg.NotifyNewLogin {
+			return nil
+		}
+		return checkNewLogin(ctx, lookup, e)
+	})
+}
+
+func checkNewLogin(ctx context.Context, lookup RecipientLookup, e eventbus.UserLoggedIn) error {
+	devicesKey := redisKeyPrefix + "devices:" + e.UserID
+	countriesKey := redisKeyPrefix + "countries:" + e.UserID
+	device := deviceFingerprint(e.Meta.UserAgent)
+
+	pipe := datastore.GetRedisInstance().TxPipeline()
+	known := pipe.Exists(ctx, devicesKey, countriesKey)
+	newDevice := pipe.SAdd(ctx, devicesKey, device)
+	pipe.Expire(ctx, devicesKey, knownLoginTTL)
+	var newCountry *redis.IntCmd
+	if e.Meta.Country != "" {
+		newCountry = pipe.SAdd(ctx, countriesKey, e.Meta.Country)
+		pipe.Expire(ctx, countriesKey, knownLoginTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "记录登录设备失败")
+	}
+
+	// 首次登录只记录不提醒
+	if known.Val() == 0 {
+		return nil
+	}
+	if newDevice.Val() == 0 && (newCountry == nil || newCountry.Val() == 0) {
+		return nil
+	}
+
+	mailer := email.GetMailerInstance()
+	if mailer == nil {
+		return nil
+	}
+	user, err := lookup(ctx, e.UserID)
+	if err != nil {
+		return errors.Wrap(err, "查询用户失败")
+	}
+	if user.Email == "" {
+		return nil
+	}
+
+	agent := e.Meta.UserAgent
+	if len(agent) > maxDeviceLen {
+		agent = agent[:maxDeviceLen]
+	}
+	logger.FromContext(ctx).Info("检测到新设备或新地区登录", zap.String("user_id", e.UserID), zap.String("country", e.Meta.Country))
+	return mailer.SendTemplate(user.Email, newLoginTemplate, user.Locale, map[string]any{
+		"Name":    user.Name,
+		"Time":    e.OccurredAt.Format("2006/01/02 - 15:04:05"),
+		"IP":      e.Meta.IP,
+		"Country": e.Meta.Country,
+		"Device":  agent,
+	})
+}
+
+// deviceFingerprint 按User-Agent区分设备
+func deviceFingerprint(userAgent string) string {
+	sum := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,271 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/wire"
+)
+
+// Config 应用配置 字段标签:
+//
+//	env      环境变量名 优先级最高
+//	yaml/toml 配置文件中的键
+//	default  默认值
+//	required 为true时缺失会报错
+//	reload   为true时支持SIGHUP热加载 其余配置修改后需重启
+//
+// 敏感字段使用 Secret 类型 打印时自动脱敏
+type Config struct {
+	Server     ServerConfig     `yaml:"server" toml:"server"`
+	Log        LogConfig        `yaml:"log" toml:"log"`
+	PSQL       PSQLConfig       `yaml:"psql" toml:"psql"`
+	Redis      RedisConfig      `yaml:"redis" toml:"redis"`
+	JWT        JWTConfig        `yaml:"jwt" toml:"jwt"`
+	Email      EmailConfig      `yaml:"email" toml:"email"`
+	Github     GithubConfig     `yaml:"github" toml:"github"`
+	Prometheus PrometheusConfig `yaml:"prometheus" toml:"prometheus"`
+	Tracing    TracingConfig    `yaml:"tracing" toml:"tracing"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit" toml:"rate_limit"`
+	AuthGuard  AuthGuardConfig  `yaml:"auth_guard" toml:"auth_guard"`
+	// 服务间令牌内省
+	Introspection IntrospectionConfig `yaml:"introspection" toml:"introspection"`
+}
+
+type ServerConfig struct {
+	Mode         string   `env:"SERVER_MODE" yaml:"mode" toml:"mode" default:"release"`
+	Port         string   `env:"SERVER_PORT" yaml:"port" toml:"port" default:"8080"`
+	AllowOrigins []string `env:"SERVER_ALLOW_ORIGINS" yaml:"allow_origins" toml:"allow_origins" required:"true" reload:"true"`
+	// 可信反向代理的IP或CIDR 只有来自这些地址的X-Forwarded-For才会被采信
+	// 为空时不信任任何代理 客户端IP取连接的对端地址 限流与登录防护依赖该值
+	TrustedProxies []string `env:"SERVER_TRUSTED_PROXIES" yaml:"trusted_proxies" toml:"trusted_proxies"`
+	// 可访问/api/admin接口的用户ID
+	AdminUserIDs []string `env:"SERVER_ADMIN_USER_IDS" yaml:"admin_user_ids" toml:"admin_user_ids" reload:"true"`
+	// 错误响应始终使用RFC 7807格式 关闭时仅在Accept包含application/problem+json时使用
+	ProblemJSON bool `env:"SERVER_PROBLEM_JSON" yaml:"problem_json" toml:"problem_json" default:"false" reload:"true"`
+	// problem+json中type字段的前缀 后接错误码
+	ProblemTypeBase string `env:"SERVER_PROBLEM_TYPE_BASE" yaml:"problem_type_base" toml:"problem_type_base" default:"/errors/" reload:"true"`
+	// 单个请求的处理超时 超时后取消数据库与Redis操作 0表示不限制
+	RequestTimeout time.Duration `env:"SERVER_REQUEST_TIMEOUT" yaml:"request_timeout" toml:"request_timeout" default:"30s"`
+	// 收到退出信号后/readyz先返回503 等待该时长再关闭 便于负载均衡摘除实例
+	ShutdownDelay time.Duration `env:"SERVER_SHUTDOWN_DELAY" yaml:"shutdown_delay" toml:"shutdown_delay" default:"0s"`
+	// gRPC服务端口 为空时不启动 与HTTP服务一同优雅关闭
+	GrpcPort string `env:"SERVER_GRPC_PORT" yaml:"grpc_port" toml:"grpc_port" default:"9090"`
+}
+
+// IsDev 是否为开发模式
+func (c ServerConfig) IsDev() bool {
+	return c.Mode == "dev"
+}
+
+type LogConfig struct {
+	Level      string `env:"LOG_LEVEL" yaml:"level" toml:"level" default:"info" reload:"true"`
+	FileName   string `env:"LOG_FILENAME" yaml:"filename" toml:"filename" default:"logs/logs.log"`
+	MaxSize    int    `env:"LOG_MAX_SIZE" yaml:"max_size" toml:"max_size" default:"1"`
+	MaxAge     int    `env:"LOG_MAX_AGE" yaml:"max_age" toml:"max_age" default:"30"`
+	MaxBackups int    `env:"LOG_MAX_BACKUPS" yaml:"max_backups" toml:"max_backups" default:"7"`
+
+	// 容器部署时可关闭文件输出 只输出到stdout
+	FileEnabled bool `env:"LOG_FILE_ENABLED" yaml:"file_enabled" toml:"file_enabled" default:"true"`
+	// 各输出的编码格式 json/console
+	StdoutEncoder string `env:"LOG_STDOUT_ENCODER" yaml:"stdout_encoder" toml:"stdout_encoder" default:"json"`
+	FileEncoder   string `env:"LOG_FILE_ENCODER" yaml:"file_encoder" toml:"file_encoder" default:"json"`
+
+	// 采样 同一秒内相同消息超过Initial条后每Thereafter条记录一条 Initial为0时不采样
+	SamplingInitial    int `env:"LOG_SAMPLING_INITIAL" yaml:"sampling_initial" toml:"sampling_initial" default:"0"`
+	SamplingThereafter int `env:"LOG_SAMPLING_THEREAFTER" yaml:"sampling_thereafter" toml:"sampling_thereafter" default:"100"`
+
+	// 日志脱敏 令牌、密码、Authorization等字段及内容中的令牌替换为[REDACTED]
+	Redact bool `env:"LOG_REDACT" yaml:"redact" toml:"redact" default:"true"`
+	// 邮箱只保留首字母与域名 开发环境可关闭便于调试
+	RedactEmails bool `env:"LOG_REDACT_EMAILS" yaml:"redact_emails" toml:"redact_emails" default:"true"`
+	// 额外的敏感字段名片段 不区分大小写
+	RedactKeys []string `env:"LOG_REDACT_KEYS" yaml:"redact_keys" toml:"redact_keys"`
+}
+
+type PSQLConfig struct {
+	Host     string `env:"PSQL_HOST" yaml:"host" toml:"host" required:"true"`
+	Port     string `env:"PSQL_PORT" yaml:"port" toml:"port" default:"5432"`
+	Username string `env:"PSQL_USERNAME" yaml:"username" toml:"username" required:"true"`
+	Password Secret `env:"PSQL_PASSWORD" yaml:"password" toml:"password"`
+	DBName   string `env:"PSQL_DB_NAME" yaml:"db_name" toml:"db_name" required:"true"`
+	SSLMode  string `env:"PSQL_SSL_MODE" yaml:"ssl_mode" toml:"ssl_mode" default:"disable"`
+
+	// 连接池
+	MaxOpenConns    int           `env:"PSQL_MAX_OPEN_CONNS" yaml:"max_open_conns" toml:"max_open_conns" default:"20"`
+	MaxIdleConns    int           `env:"PSQL_MAX_IDLE_CONNS" yaml:"max_idle_conns" toml:"max_idle_conns" default:"10"`
+	ConnMaxLifetime time.Duration `env:"PSQL_CONN_MAX_LIFETIME" yaml:"conn_max_lifetime" toml:"conn_max_lifetime" default:"30m"`
+	ConnMaxIdleTime time.Duration `env:"PSQL_CONN_MAX_IDLE_TIME" yaml:"conn_max_idle_time" toml:"conn_max_idle_time" default:"5m"`
+	ConnectTimeout  time.Duration `env:"PSQL_CONNECT_TIMEOUT" yaml:"connect_timeout" toml:"connect_timeout" default:"5s"`
+	// 启动时连接失败的重试次数 间隔指数退避
+	ConnectRetries int `env:"PSQL_CONNECT_RETRIES" yaml:"connect_retries" toml:"connect_retries" default:"5"`
+}
+
+// DSN lib/pq连接字符串 每个值都加引号 密码中的空格、引号和反斜杠不会破坏解析
+func (c PSQLConfig) DSN() string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s connect_timeout=%d",
+		quoteDSN(c.Host), quoteDSN(c.Port), quoteDSN(c.Username), quoteDSN(c.Password.Value()),
+		quoteDSN(c.DBName), quoteDSN(c.SSLMode), int(c.ConnectTimeout.Seconds()),
+	)
+}
+
+func quoteDSN(value string) string {
+	return "'" + dsnEscaper.Replace(value) + "'"
+}
+
+var dsnEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+type RedisConfig struct {
+	Host     string `env:"REDIS_HOST" yaml:"host" toml:"host" required:"true"`
+	Port     string `env:"REDIS_PORT" yaml:"port" toml:"port" default:"6379"`
+	Password Secret `env:"REDIS_PASSWORD" yaml:"password" toml:"password"`
+	DB       int    `env:"REDIS_DB" yaml:"db" toml:"db" default:"0"`
+	PoolSize int    `env:"REDIS_POOL_SIZE" yaml:"pool_size" toml:"pool_size" default:"10"`
+
+	MinIdleConns int           `env:"REDIS_MIN_IDLE_CONNS" yaml:"min_idle_conns" toml:"min_idle_conns" default:"0"`
+	DialTimeout  time.Duration `env:"REDIS_DIAL_TIMEOUT" yaml:"dial_timeout" toml:"dial_timeout" default:"5s"`
+	ReadTimeout  time.Duration `env:"REDIS_READ_TIMEOUT" yaml:"read_timeout" toml:"read_timeout" default:"3s"`
+	WriteTimeout time.Duration `env:"REDIS_WRITE_TIMEOUT" yaml:"write_timeout" toml:"write_timeout" default:"3s"`
+	// 连接池耗尽时等待空闲连接的时间
+	PoolTimeout time.Duration `env:"REDIS_POOL_TIMEOUT" yaml:"pool_timeout" toml:"pool_timeout" default:"4s"`
+	// 启动时连接失败的重试次数 间隔指数退避
+	ConnectRetries int `env:"REDIS_CONNECT_RETRIES" yaml:"connect_retries" toml:"connect_retries" default:"5"`
+}
+
+// Addr host:port
+func (c RedisConfig) Addr() string {
+	return c.Host + ":" + c.Port
+}
+
+type JWTConfig struct {
+	// 签发使用Secret 校验时依次尝试Secret和PreviousSecrets 轮换密钥时将旧密钥移入PreviousSecrets
+	Secret          Secret   `env:"JWT_SECRET" yaml:"secret" toml:"secret" required:"true" reload:"true"`
+	PreviousSecrets []Secret `env:"JWT_PREVIOUS_SECRETS" yaml:"previous_secrets" toml:"previous_secrets" reload:"true"`
+	ExpireMinute    int      `env:"JWT_EXPIRE_MINUTE" yaml:"expire_minute" toml:"expire_minute" default:"120" reload:"true"`
+}
+
+// Expire 访问令牌有效期
+func (c JWTConfig) Expire() time.Duration {
+	return time.Duration(c.ExpireMinute) * time.Minute
+}
+
+// VerifyKeys 校验令牌可用的全部密钥 当前密钥在前
+func (c JWTConfig) VerifyKeys() []string {
+	keys := make([]string, 0, len(c.PreviousSecrets)+1)
+	keys = append(keys, c.Secret.Value())
+	for _, s := range c.PreviousSecrets {
+		keys = append(keys, s.Value())
+	}
+	return keys
+}
+
+type EmailConfig struct {
+	// 投递方式 smtp/api/log/file 多个以逗号分隔按顺序故障转移
+	Driver        string `env:"EMAIL_DRIVER" yaml:"driver" toml:"driver" reload:"true"`
+	Host          string `env:"EMAIL_HOST" yaml:"host" toml:"host" reload:"true"`
+	Port          int    `env:"EMAIL_PORT" yaml:"port" toml:"port" reload:"true"`
+	Username      string `env:"EMAIL_USERNAME" yaml:"username" toml:"username" reload:"true"`
+	Password      Secret `env:"EMAIL_PASSWORD" yaml:"password" toml:"password" reload:"true"`
+	SMTPPoolSize  int    `env:"EMAIL_SMTP_POOL_SIZE" yaml:"smtp_pool_size" toml:"smtp_pool_size" default:"2" reload:"true"`
+	APIURL        string `env:"EMAIL_API_URL" yaml:"api_url" toml:"api_url" reload:"true"`
+	APIKey        Secret `env:"EMAIL_API_KEY" yaml:"api_key" toml:"api_key" reload:"true"`
+	From          string `env:"EMAIL_FROM" yaml:"from" toml:"from" reload:"true"`
+	FromName      string `env:"EMAIL_FROM_NAME" yaml:"from_name" toml:"from_name" default:"SaaS Scaffold" reload:"true"`
+	FileDir       string `env:"EMAIL_FILE_DIR" yaml:"file_dir" toml:"file_dir" default:"logs/mails" reload:"true"`
+	WebhookSecret Secret `env:"EMAIL_WEBHOOK_SECRET" yaml:"webhook_secret" toml:"webhook_secret" reload:"true"`
+	// 前端地址 邮件中的链接以此为前缀
+	AppURL string `env:"EMAIL_APP_URL" yaml:"app_url" toml:"app_url" default:"http://localhost:5173" reload:"true"`
+}
+
+type TracingConfig struct {
+	Enabled bool `env:"TRACING_ENABLED" yaml:"enabled" toml:"enabled" default:"false"`
+	// 导出方式 otlp/stdout
+	Exporter    string `env:"TRACING_EXPORTER" yaml:"exporter" toml:"exporter" default:"otlp"`
+	ServiceName string `env:"TRACING_SERVICE_NAME" yaml:"service_name" toml:"service_name" default:"sass-scaffold"`
+	// OTLP/HTTP 接收地址 host:port
+	Endpoint string `env:"TRACING_OTLP_ENDPOINT" yaml:"otlp_endpoint" toml:"otlp_endpoint" default:"localhost:4318"`
+	Insecure bool   `env:"TRACING_OTLP_INSECURE" yaml:"otlp_insecure" toml:"otlp_insecure" default:"true"`
+	// 采样比例 0~1 存在上游采样决定时沿用上游
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" yaml:"sample_ratio" toml:"sample_ratio" default:"1"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `env:"RATE_LIMIT_ENABLED" yaml:"enabled" toml:"enabled" default:"true" reload:"true"`
+	// 存储后端 redis/memory memory仅在单实例内计数 用于测试与本地开发
+	Backend string `env:"RATE_LIMIT_BACKEND" yaml:"backend" toml:"backend" default:"redis"`
+	// 每个周期允许的请求数 允许一次性突发用完
+	Period time.Duration `env:"RATE_LIMIT_PERIOD" yaml:"period" toml:"period" default:"1m" reload:"true"`
+	// 未登录请求按IP限制
+	Anonymous int `env:"RATE_LIMIT_ANONYMOUS" yaml:"anonymous" toml:"anonymous" default:"60" reload:"true"`
+	// 登录用户按订阅计划限制 格式 plan_type=次数 未匹配的计划使用free
+	// plan_type对应plans表的主键 该值为每周期的突发限额 与plans.max_api_calls_monthly的月度配额相互独立
+	Plans []string `env:"RATE_LIMIT_PLANS" yaml:"plans" toml:"plans" default:"free=120,pro=1200,enterprise=6000" reload:"true"`
+}
+
+type AuthGuardConfig struct {
+	Enabled bool `env:"AUTH_GUARD_ENABLED" yaml:"enabled" toml:"enabled" default:"true" reload:"true"`
+	// 窗口内连续失败MaxFailures次后锁定 每次锁定时长翻倍 最长LockoutMax
+	MaxFailures   int           `env:"AUTH_GUARD_MAX_FAILURES" yaml:"max_failures" toml:"max_failures" default:"5" reload:"true"`
+	FailureWindow time.Duration `env:"AUTH_GUARD_FAILURE_WINDOW" yaml:"failure_window" toml:"failure_window" default:"15m" reload:"true"`
+	LockoutBase   time.Duration `env:"AUTH_GUARD_LOCKOUT_BASE" yaml:"lockout_base" toml:"lockout_base" default:"1m" reload:"true"`
+	LockoutMax    time.Duration `env:"AUTH_GUARD_LOCKOUT_MAX" yaml:"lockout_max" toml:"lockout_max" default:"1h" reload:"true"`
+	// 验证码 none/stub stub只接受CaptchaStubToken 用于测试 失败CaptchaAfter次后要求验证码 0表示不要求
+	Captcha          string `env:"AUTH_GUARD_CAPTCHA" yaml:"captcha" toml:"captcha" default:"none"`
+	CaptchaAfter     int    `env:"AUTH_GUARD_CAPTCHA_AFTER" yaml:"captcha_after" toml:"captcha_after" default:"3" reload:"true"`
+	CaptchaStubToken string `env:"AUTH_GUARD_CAPTCHA_STUB_TOKEN" yaml:"captcha_stub_token" toml:"captcha_stub_token" default:"captcha-ok"`
+	// 新设备或新地区登录时发送提醒邮件 地区取自CDN写入的请求头
+	NotifyNewLogin bool   `env:"AUTH_GUARD_NOTIFY_NEW_LOGIN" yaml:"notify_new_login" toml:"notify_new_login" default:"true" reload:"true"`
+	CountryHeader  string `env:"AUTH_GUARD_COUNTRY_HEADER" yaml:"country_header" toml:"country_header" default:"CF-IPCountry"`
+}
+
+type IntrospectionConfig struct {
+	// 允许调用内省接口的服务 格式为client_id:secret 为空时拒绝所有调用
+	Clients []Secret `env:"INTROSPECTION_CLIENTS" yaml:"clients" toml:"clients" reload:"true"`
+}
+
+type GithubConfig struct {
+	ClientID     string `env:"GITHUB_CLIENT_ID" yaml:"client_id" toml:"client_id" required:"true"`
+	ClientSecret Secret `env:"GITHUB_CLIENT_SECRET" yaml:"client_secret" toml:"client_secret" required:"true"`
+}
+
+type PrometheusConfig struct {
+	Enabled bool   `env:"PROMETHEUS_ENABLED" yaml:"enabled" toml:"enabled" default:"false"`
+	Path    string `env:"PROMETHEUS_PATH" yaml:"path" toml:"path" default:"/metrics"`
+	Port    string `env:"PROMETHEUS_ADDR" yaml:"port" toml:"port" default:"2112"`
+}
+
+var instance atomic.Pointer[Config]
+
+// Init 加载全局配置 需在其他模块之前调用
+func Init() error {
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+	instance.Store(cfg)
+	return nil
+}
+
+// GetConfigInstance 获取全局配置实例 热加载后返回新配置 调用方不应修改
+func GetConfigInstance() *Config {
+	return instance.Load()
+}
+
+// Source 读取当前生效的配置 热加载后返回新值
+// 需要随热加载更新的组件注入Source按需读取 不在构造函数中注册回调
+type Source func() *Config
+
+// NewSource 返回读取全局配置的Source
+func NewSource() Source {
+	return GetConfigInstance
+}
+
+// ProviderSet wire注入 模块按需依赖各分组配置
+var ProviderSet = wire.NewSet(
+	GetConfigInstance,
+	NewSource,
+	wire.FieldsOf(new(*Config), "Server", "Log", "PSQL", "Redis", "JWT", "Email", "Github", "Prometheus", "Tracing", "RateLimit", "AuthGuard", "Introspection"),
+)
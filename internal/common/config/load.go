@@ -0,0 +1,195 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// 未指定CONFIG_FILE时 按顺序查找工作目录下的配置文件 均不存在则只使用环境变量
+var defaultFiles = []string{"config.yaml", "config.yml", "config.toml"}
+
+// Load 按 默认值 < 配置文件 < .env < 环境变量 的优先级加载配置
+// 所有缺失或格式错误的配置项会在一个错误中一并返回
+func Load() (*Config, error) {
+	cfg := new(Config)
+	var errs loadErrors
+
+	walk(reflect.ValueOf(cfg).Elem(), func(f reflect.StructField, v reflect.Value) {
+		if def, ok := f.Tag.Lookup("default"); ok {
+			if err := setValue(v, def); err != nil {
+				errs.invalid = append(errs.invalid, fmt.Sprintf("%s默认值: %v", f.Tag.Get("env"), err))
+			}
+		}
+	})
+
+	// .env只读取不写入进程环境变量 热加载时才能读到修改后的值
+	dotenv, _ := godotenv.Read()
+	lookup := func(key string) string {
+		if v, ok := os.LookupEnv(key); ok {
+			return v
+		}
+		return dotenv[key]
+	}
+
+	if err := loadFile(cfg, lookup("CONFIG_FILE")); err != nil {
+		return nil, err
+	}
+
+	walk(reflect.ValueOf(cfg).Elem(), func(f reflect.StructField, v reflect.Value) {
+		key := f.Tag.Get("env")
+		if raw := lookup(key); raw != "" {
+			if err := setValue(v, raw); err != nil {
+				errs.invalid = append(errs.invalid, fmt.Sprintf("%s: %v", key, err))
+				return
+			}
+		}
+
+		if f.Tag.Get("required") == "true" && v.IsZero() {
+			errs.missing = append(errs.missing, key)
+		}
+	})
+
+	if errs.has() {
+		return nil, errs
+	}
+	return cfg, nil
+}
+
+func loadFile(cfg *Config, path string) error {
+	if path == "" {
+		for _, f := range defaultFiles {
+			if _, err := os.Stat(f); err == nil {
+				path = f
+				break
+			}
+		}
+	}
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.WithMessagef(err, "读取配置文件%s失败", path)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	case ".toml":
+		err = toml.Unmarshal(data, cfg)
+	default:
+		return errors.Errorf("不支持的配置文件格式: %s", path)
+	}
+	return errors.WithMessagef(err, "解析配置文件%s失败", path)
+}
+
+// walk 遍历所有带env标签的配置项
+func walk(v reflect.Value, fn func(f reflect.StructField, v reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f, fv := t.Field(i), v.Field(i)
+		if _, ok := f.Tag.Lookup("env"); ok {
+			fn(f, fv)
+			continue
+		}
+		if fv.Kind() == reflect.Struct {
+			walk(fv, fn)
+		}
+	}
+}
+
+func setValue(v reflect.Value, raw string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Int64:
+		if v.Type() != reflect.TypeOf(time.Duration(0)) {
+			return errors.Errorf("不支持的配置类型%s", v.Type())
+		}
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return errors.Errorf("%q不是时长 如30s、5m", raw)
+		}
+		v.SetInt(int64(d))
+	case reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return errors.Errorf("%q不是整数", raw)
+		}
+		v.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return errors.Errorf("%q不是数字", raw)
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return errors.Errorf("%q不是布尔值", raw)
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return errors.Errorf("不支持的配置类型%s", v.Type())
+		}
+		// 逗号分隔的字符串列表
+		items := reflect.MakeSlice(v.Type(), 0, 0)
+		for _, s := range strings.Split(raw, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = reflect.Append(items, reflect.ValueOf(s).Convert(v.Type().Elem()))
+			}
+		}
+		v.Set(items)
+	default:
+		return errors.Errorf("不支持的配置类型%s", v.Type())
+	}
+	return nil
+}
+
+type loadErrors struct {
+	missing []string
+	invalid []string
+}
+
+func (e loadErrors) has() bool {
+	return len(e.missing) > 0 || len(e.invalid) > 0
+}
+
+func (e loadErrors) Error() string {
+	var parts []string
+	if len(e.missing) > 0 {
+		parts = append(parts, "缺少必填配置项: "+strings.Join(e.missing, ", "))
+	}
+	if len(e.invalid) > 0 {
+		parts = append(parts, "配置项格式错误: "+strings.Join(e.invalid, "; "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// String 按 环境变量名=值 的形式输出全部配置 敏感字段已脱敏
+func (c *Config) String() string {
+	var sb strings.Builder
+	walk(reflect.ValueOf(c).Elem(), func(f reflect.StructField, v reflect.Value) {
+		sb.WriteString(f.Tag.Get("env"))
+		sb.WriteByte('=')
+		if items, ok := v.Interface().([]string); ok {
+			sb.WriteString(strings.Join(items, ","))
+		} else {
+			fmt.Fprint(&sb, v.Interface())
+		}
+		sb.WriteByte('\n')
+	})
+	return sb.String()
+}
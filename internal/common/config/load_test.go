@@ -0,0 +1,233 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// 必填项的最小集合
+var requiredEnv = map[string]string{
+	"SERVER_ALLOW_ORIGINS": "http://localhost:5173",
+	"PSQL_HOST":            "localhost",
+	"PSQL_USERNAME":        "postgres",
+	"PSQL_DB_NAME":         "scaffold",
+	"REDIS_HOST":           "localhost",
+	"JWT_SECRET":           "jwt-secret",
+	"GITHUB_CLIENT_ID":     "client-id",
+	"GITHUB_CLIENT_SECRET": "client-secret",
+}
+
+// isolate 切换到空的临时目录并清空所有配置相关的环境变量 结束后恢复
+func isolate(t *testing.T) {
+	t.Helper()
+
+	keys := []string{"CONFIG_FILE"}
+	walk(reflect.ValueOf(new(Config)).Elem(), func(f reflect.StructField, _ reflect.Value) {
+		keys = append(keys, f.Tag.Get("env"))
+	})
+	for _, key := range keys {
+		if v, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			t.Cleanup(func() { os.Setenv(key, v) })
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func setEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr []string
+	}{
+		{
+			name: "必填项齐全",
+			env:  map[string]string{},
+		},
+		{
+			name:    "缺少必填项",
+			env:     map[string]string{"PSQL_HOST": "", "JWT_SECRET": ""},
+			wantErr: []string{"缺少必填配置项", "PSQL_HOST", "JWT_SECRET"},
+		},
+		{
+			name:    "整数格式错误",
+			env:     map[string]string{"EMAIL_PORT": "abc"},
+			wantErr: []string{"配置项格式错误", "EMAIL_PORT"},
+		},
+		{
+			name:    "时长格式错误",
+			env:     map[string]string{"SERVER_REQUEST_TIMEOUT": "30"},
+			wantErr: []string{"SERVER_REQUEST_TIMEOUT"},
+		},
+		{
+			name:    "布尔格式错误",
+			env:     map[string]string{"RATE_LIMIT_ENABLED": "yes please"},
+			wantErr: []string{"RATE_LIMIT_ENABLED"},
+		},
+		{
+			name:    "缺失与格式错误一并返回",
+			env:     map[string]string{"REDIS_HOST": "", "EMAIL_PORT": "abc"},
+			wantErr: []string{"REDIS_HOST", "EMAIL_PORT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolate(t)
+			setEnv(t, requiredEnv)
+			setEnv(t, tt.env)
+
+			cfg, err := Load()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Load() err=%v", err)
+				}
+				if cfg.PSQL.Host != "localhost" || cfg.Server.AllowOrigins[0] != "http://localhost:5173" {
+					t.Fatalf("必填项未加载 %+v", cfg.Server)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Load() 期望返回错误")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("错误 %q 未包含 %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		dotenv string
+		env    string
+		want   string
+	}{
+		{name: "默认值", want: "8080"},
+		{name: "配置文件覆盖默认值", file: "8081", want: "8081"},
+		{name: ".env覆盖配置文件", file: "8081", dotenv: "8082", want: "8082"},
+		{name: "环境变量优先级最高", file: "8081", dotenv: "8082", env: "8083", want: "8083"},
+		{name: "环境变量覆盖默认值", env: "8083", want: "8083"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolate(t)
+			setEnv(t, requiredEnv)
+			if tt.file != "" {
+				writeFile(t, "config.yaml", "server:\n  port: \""+tt.file+"\"\n")
+			}
+			if tt.dotenv != "" {
+				writeFile(t, ".env", "SERVER_PORT="+tt.dotenv+"\n")
+			}
+			if tt.env != "" {
+				t.Setenv("SERVER_PORT", tt.env)
+			}
+
+			cfg, err := Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Server.Port != tt.want {
+				t.Fatalf("SERVER_PORT=%s 期望%s", cfg.Server.Port, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFileFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr bool
+	}{
+		{name: "yaml", file: "app.yaml", content: "jwt:\n  expire_minute: 30\n"},
+		{name: "toml", file: "app.toml", content: "[jwt]\nexpire_minute = 30\n"},
+		{name: "格式错误", file: "app.yaml", content: "jwt: [", wantErr: true},
+		{name: "不支持的扩展名", file: "app.ini", content: "expire_minute=30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolate(t)
+			setEnv(t, requiredEnv)
+			writeFile(t, tt.file, tt.content)
+			t.Setenv("CONFIG_FILE", tt.file)
+
+			cfg, err := Load()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Load() 期望返回错误")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.JWT.ExpireMinute != 30 {
+				t.Fatalf("JWT_EXPIRE_MINUTE=%d 期望30", cfg.JWT.ExpireMinute)
+			}
+		})
+	}
+}
+
+func TestStringRedactsSecrets(t *testing.T) {
+	cfg := &Config{}
+	cfg.JWT.Secret = "jwt-secret-value"
+	cfg.JWT.PreviousSecrets = []Secret{"old-secret-value"}
+	cfg.Github.ClientID = "client-id"
+	cfg.Server.AllowOrigins = []string{"http://a.com", "http://b.com"}
+	out := cfg.String()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "密钥脱敏", want: "JWT_SECRET=" + redacted + "\n"},
+		{name: "空密钥不输出占位符", want: "GITHUB_CLIENT_SECRET=\n"},
+		{name: "普通字段原样输出", want: "GITHUB_CLIENT_ID=client-id\n"},
+		{name: "列表以逗号连接", want: "SERVER_ALLOW_ORIGINS=http://a.com,http://b.com\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(out, tt.want) {
+				t.Fatalf("输出中缺少 %q", tt.want)
+			}
+		})
+	}
+
+	for _, secret := range []string{"jwt-secret-value", "old-secret-value"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("输出中包含明文密钥 %q", secret)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// ReloadFunc 热加载回调 old/new均为只读
+type ReloadFunc func(old, new *Config)
+
+type reloadEntry struct {
+	id uint64
+	fn ReloadFunc
+}
+
+var (
+	// applyMu 串行执行Reload reloadMu只保护回调列表 回调在锁外执行
+	applyMu     sync.Mutex
+	reloadMu    sync.Mutex
+	reloadSeq   uint64
+	reloadFuncs []reloadEntry
+)
+
+// OnReload 注册热加载回调 按注册顺序执行 返回取消注册的函数
+// 仅应在启动装配时注册一次 需要读取最新配置的组件优先使用Source
+func OnReload(fn ReloadFunc) (unsubscribe func()) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	reloadSeq++
+	id := reloadSeq
+	reloadFuncs = append(reloadFuncs, reloadEntry{id: id, fn: fn})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			reloadMu.Lock()
+			defer reloadMu.Unlock()
+			for i, e := range reloadFuncs {
+				if e.id == id {
+					reloadFuncs = append(reloadFuncs[:i:i], reloadFuncs[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+}
+
+func reloadSnapshot() []reloadEntry {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	return append([]reloadEntry(nil), reloadFuncs...)
+}
+
+// Reload 重新加载配置 加载或校验失败时保留原配置
+// 不支持热加载的配置项即使修改也沿用原值 并记录需要重启的配置项
+func Reload() error {
+	applyMu.Lock()
+	defer applyMu.Unlock()
+
+	next, err := Load()
+	if err != nil {
+		return err
+	}
+
+	old := instance.Load()
+	if old == nil {
+		instance.Store(next)
+		return nil
+	}
+
+	restart := keepStatic(old, next)
+	if len(restart) > 0 {
+		zap.L().Warn("以下配置修改需要重启才能生效", zap.Strings("keys", restart))
+	}
+
+	instance.Store(next)
+	// 回调中可再次注册或取消注册 不会死锁
+	for _, e := range reloadSnapshot() {
+		e.fn(old, next)
+	}
+
+	zap.L().Info("配置热加载完成")
+	return nil
+}
+
+// keepStatic 将next中不支持热加载的配置项恢复为old的值 返回发生变化的配置项
+func keepStatic(old, next *Config) []string {
+	var changed []string
+	oldV := reflect.ValueOf(old).Elem()
+	walkPair(oldV, reflect.ValueOf(next).Elem(), func(f reflect.StructField, o, n reflect.Value) {
+		if f.Tag.Get("reload") == "true" || reflect.DeepEqual(o.Interface(), n.Interface()) {
+			return
+		}
+		changed = append(changed, f.Tag.Get("env"))
+		n.Set(o)
+	})
+	return changed
+}
+
+func walkPair(a, b reflect.Value, fn func(f reflect.StructField, a, b reflect.Value)) {
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if _, ok := f.Tag.Lookup("env"); ok {
+			fn(f, a.Field(i), b.Field(i))
+			continue
+		}
+		if a.Field(i).Kind() == reflect.Struct {
+			walkPair(a.Field(i), b.Field(i), fn)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import "testing"
+
+func TestOnReloadUnsubscribe(t *testing.T) {
+	before := len(reloadSnapshot())
+
+	unsubscribe := OnReload(func(_, _ *Config) {})
+	other := OnReload(func(_, _ *Config) {})
+	defer other()
+	if got := len(reloadSnapshot()); got != before+2 {
+		t.Fatalf("注册后回调数量=%d 期望%d", got, before+2)
+	}
+
+	unsubscribe()
+	unsubscribe()
+	if got := len(reloadSnapshot()); got != before+1 {
+		t.Fatalf("取消注册后回调数量=%d 期望%d", got, before+1)
+	}
+}
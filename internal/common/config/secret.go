@@ -0,0 +1,27 @@
+package config
+
+import "encoding/json"
+
+const redacted = "******"
+
+// Secret 敏感配置 通过fmt、zap或json输出时均会脱敏 使用Value获取原值
+type Secret string
+
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -0,0 +1,101 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/config"
+)
+
+var (
+	db          *sql.DB
+	redisClient *redis.Client
+)
+
+// Init 建立全局数据库与Redis连接池 连接失败时按配置重试
+func Init(psql config.PSQLConfig, rc config.RedisConfig) error {
+	var err error
+	if db, err = openDB(psql); err != nil {
+		return errors.WithMessage(err, "数据库连接失败")
+	}
+	if redisClient, err = openRedis(rc); err != nil {
+		_ = db.Close()
+		db = nil
+		return errors.WithMessage(err, "redis连接失败")
+	}
+
+	registerMetrics(db, redisClient)
+	registerHealth(db, redisClient)
+	return nil
+}
+
+// GetDBInstance 获取全局数据库连接池 需先调用Init
+func GetDBInstance() *sql.DB {
+	if db == nil {
+		panic("datastore未初始化")
+	}
+	return db
+}
+
+// GetRedisInstance 获取全局Redis客户端 需先调用Init
+func GetRedisInstance() *redis.Client {
+	if redisClient == nil {
+		panic("datastore未初始化")
+	}
+	return redisClient
+}
+
+// Close 关闭连接池 应在所有使用方停止后调用
+func Close() error {
+	var err error
+	if db != nil {
+		if e := db.Close(); e != nil {
+			err = errors.WithMessage(e, "数据库关闭失败")
+		}
+	}
+	if redisClient != nil {
+		if e := redisClient.Close(); e != nil && err == nil {
+			err = errors.WithMessage(e, "redis关闭失败")
+		}
+	}
+	return err
+}
+
+// ProviderSet wire注入
+var ProviderSet = wire.NewSet(GetDBInstance, GetRedisInstance)
+
+const (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+)
+
+// retry 执行fn直到成功 失败后等待 500ms,1s,2s... 最长10s
+func retry(name string, retries int, fn func(ctx context.Context) error) error {
+	delay := retryBaseDelay
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retryMaxDelay)
+		err := fn(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= retries {
+			return err
+		}
+
+		zap.L().Warn("连接失败 等待重试",
+			zap.String("target", name),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+		time.Sleep(delay)
+		delay = min(delay*2, retryMaxDelay)
+	}
+}
@@ -0,0 +1,63 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"sass-scaffold/internal/common/health"
+)
+
+func registerHealth(db *sql.DB, client *redis.Client) {
+	health.Register(health.NewChecker("postgres", db.PingContext))
+	health.Register(health.NewChecker("citus_workers", func(ctx context.Context) error {
+		return checkCitusWorkers(ctx, db)
+	}))
+	health.Register(health.NewChecker("redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}))
+}
+
+// checkCitusWorkers 在协调节点上确认存在活跃的worker 并逐个执行简单查询确认可达
+func checkCitusWorkers(ctx context.Context, db *sql.DB) error {
+	var active int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM citus_get_active_worker_nodes()").Scan(&active); err != nil {
+		return err
+	}
+	if active == 0 {
+		return errors.New("没有活跃的citus worker")
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT nodename, nodeport, success, result FROM run_command_on_workers('SELECT 1')")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var failed []string
+	for rows.Next() {
+		var (
+			name    string
+			port    int
+			success bool
+			result  string
+		)
+		if err := rows.Scan(&name, &port, &success, &result); err != nil {
+			return err
+		}
+		if !success {
+			failed = append(failed, fmt.Sprintf("%s:%d(%s)", name, port, result))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("%d/%d个worker不可用: %s", len(failed), active, strings.Join(failed, ", "))
+	}
+	return nil
+}
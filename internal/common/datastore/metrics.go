@@ -0,0 +1,70 @@
+package datastore
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/metrics"
+)
+
+// registerMetrics 注册连接池状态采集器 随/metrics导出 需在metrics.Init之后调用
+func registerMetrics(db *sql.DB, client *redis.Client) {
+	for _, c := range []prometheus.Collector{
+		collectors.NewDBStatsCollector(db, "postgres"),
+		newRedisPoolCollector(client),
+	} {
+		if err := metrics.RegisterCollector(c); err != nil {
+			zap.L().Warn("连接池指标注册失败", zap.Error(err))
+		}
+	}
+}
+
+// redisPoolCollector 导出go-redis连接池状态
+type redisPoolCollector struct {
+	client *redis.Client
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+func newRedisPoolCollector(client *redis.Client) *redisPoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("go_redis_pool_"+name, help, nil, nil)
+	}
+	return &redisPoolCollector{
+		client:     client,
+		hits:       desc("hits_total", "Number of times a free connection was found in the pool."),
+		misses:     desc("misses_total", "Number of times a free connection was not found in the pool."),
+		timeouts:   desc("timeouts_total", "Number of times a wait timeout occurred."),
+		totalConns: desc("total_conns", "Number of total connections in the pool."),
+		idleConns:  desc("idle_conns", "Number of idle connections in the pool."),
+		staleConns: desc("stale_conns_total", "Number of stale connections removed from the pool."),
+	}
+}
+
+func (c *redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+}
+
+func (c *redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.client.PoolStats()
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(s.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(s.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(s.Timeouts))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(s.StaleConns))
+}
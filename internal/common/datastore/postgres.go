@@ -0,0 +1,40 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/XSAM/otelsql"
+	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+
+	"sass-scaffold/internal/common/config"
+)
+
+func openDB(cfg config.PSQLConfig) (*sql.DB, error) {
+	conn, err := openTraced("postgres", cfg.DSN())
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(cfg.MaxOpenConns)
+	conn.SetMaxIdleConns(cfg.MaxIdleConns)
+	conn.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	conn.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	err = retry("postgres", cfg.ConnectRetries, func(ctx context.Context) error {
+		return conn.PingContext(ctx)
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// openTraced sql.Open只校验参数 不建立连接 otelsql为每次查询生成span
+func openTraced(driverName, dsn string) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true}),
+	)
+}
@@ -0,0 +1,83 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// stubDriver 不连接数据库 查询返回空结果 只用于验证otelsql埋点
+type stubDriver struct{}
+
+func (stubDriver) Open(string) (driver.Conn, error) { return stubConn{}, nil }
+
+type stubConn struct{}
+
+func (stubConn) Prepare(string) (driver.Stmt, error) { return stubStmt{}, nil }
+func (stubConn) Close() error                        { return nil }
+func (stubConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (stubConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return stubRows{}, nil
+}
+
+type stubStmt struct{}
+
+func (stubStmt) Close() error                               { return nil }
+func (stubStmt) NumInput() int                              { return -1 }
+func (stubStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (stubStmt) Query([]driver.Value) (driver.Rows, error)  { return stubRows{}, nil }
+
+type stubRows struct{}
+
+func (stubRows) Columns() []string         { return []string{"id"} }
+func (stubRows) Close() error              { return nil }
+func (stubRows) Next([]driver.Value) error { return io.EOF }
+
+func init() {
+	sql.Register("stub", stubDriver{})
+}
+
+func TestOpenTracedCreatesQuerySpan(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	db, err := openTraced("stub", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	const query = "SELECT id FROM users WHERE id = $1"
+	rows, err := db.QueryContext(context.Background(), query, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rows.Close()
+
+	for _, span := range exporter.GetSpans() {
+		var system, statement bool
+		for _, attr := range span.Attributes {
+			switch {
+			case attr.Key == "db.system.name" && attr.Value == attribute.StringValue("postgresql"):
+				system = true
+			case attr.Key == "db.statement" || attr.Key == "db.query.text":
+				statement = attr.Value.AsString() == query
+			}
+		}
+		if system && statement {
+			return
+		}
+	}
+	t.Fatalf("未找到查询span: %+v", exporter.GetSpans())
+}
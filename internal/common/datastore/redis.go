@@ -0,0 +1,38 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+
+	"sass-scaffold/internal/common/config"
+)
+
+func openRedis(cfg config.RedisConfig) (*redis.Client, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:         cfg.Addr(),
+		DB:           cfg.DB,
+		Password:     cfg.Password.Value(),
+		PoolSize:     cfg.PoolSize,
+		MinIdleConns: cfg.MinIdleConns,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		PoolTimeout:  cfg.PoolTimeout,
+	})
+
+	if err := redisotel.InstrumentTracing(client); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	err := retry("redis", cfg.ConnectRetries, func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return client, nil
+}
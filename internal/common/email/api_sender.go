@@ -0,0 +1,103 @@
+package email
+
+import (
+	"encoding/base64"
+	"mime"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"resty.dev/v3"
+
+	"sass-scaffold/internal/common/tracing"
+)
+
+const apiTimeout = 10 * time.Second
+
+// apiSender 通过HTTP API投递(SES/Mailgun等服务商风格)
+//
+// 请求: POST EMAIL_API_URL  Authorization: Bearer EMAIL_API_KEY  body为apiRequest
+// 响应: 2xx 且body为 {"id": "服务商消息ID"}
+type apiSender struct {
+	client *resty.Client
+	url    string
+	key    string
+}
+
+type apiAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     string `json:"content"` // base64
+}
+
+type apiRequest struct {
+	From        string            `json:"from"`
+	To          []string          `json:"to"`
+	CC          []string          `json:"cc,omitempty"`
+	BCC         []string          `json:"bcc,omitempty"`
+	ReplyTo     string            `json:"reply_to,omitempty"`
+	Subject     string            `json:"subject"`
+	Text        string            `json:"text,omitempty"`
+	HTML        string            `json:"html,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Attachments []apiAttachment   `json:"attachments,omitempty"`
+}
+
+type apiResponse struct {
+	ID string `json:"id"`
+}
+
+func newAPISender(url, key string) *apiSender {
+	return &apiSender{
+		client: resty.NewWithClient(tracing.NewHTTPClient(apiTimeout)),
+		url:    url,
+		key:    key,
+	}
+}
+
+func (s *apiSender) send(msg *message) (*sendResult, error) {
+	from := msg.from
+	if msg.fromName != "" {
+		from = mime.QEncoding.Encode("utf-8", msg.fromName) + " <" + msg.from + ">"
+	}
+
+	req := &apiRequest{
+		From:    from,
+		To:      []string{msg.to},
+		CC:      msg.cc,
+		BCC:     msg.bcc,
+		ReplyTo: msg.replyTo,
+		Subject: msg.subject,
+		Text:    msg.text,
+		HTML:    msg.html,
+		// 回调事件中携带Message-ID 用于关联投递记录
+		Headers: map[string]string{"Message-ID": msg.id},
+	}
+	for _, a := range msg.attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+		}
+		req.Attachments = append(req.Attachments, apiAttachment{
+			Filename:    a.Filename,
+			ContentType: contentType,
+			Content:     base64.StdEncoding.EncodeToString(a.Content),
+		})
+	}
+
+	var result apiResponse
+	res, err := s.client.R().
+		SetAuthToken(s.key).
+		SetHeader("Content-Type", "application/json").
+		SetBody(req).
+		SetResult(&result).
+		Post(s.url)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if res.IsError() {
+		return nil, errors.Errorf("邮件API返回%d: %s", res.StatusCode(), res.String())
+	}
+
+	return &sendResult{provider: DriverAPI, providerMessageID: result.ID}, nil
+}
@@ -1,47 +1,141 @@
 package email
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
-	"gopkg.in/gomail.v2"
+
+	"sass-scaffold/internal/common/eventbus"
 )
 
 // Mailer 邮件发送接口
 type Mailer interface {
-	Send(to, subject string, body string) error
-	SendHTML(to, subject string, htmlBody string) error
+	Send(to, subject string, body string, opts ...Option) error
+	SendHTML(to, subject string, htmlBody string, opts ...Option) error
+	// SendTemplate 按模板名和语言渲染后发送 同时包含html和纯文本正文
+	SendTemplate(to, name, locale string, data any, opts ...Option) error
 }
 
 // GetMailerInstance 获取全局邮件客户端实例
 func GetMailerInstance() Mailer {
-	return instance
+	if m := instance.Load(); m != nil {
+		return m
+	}
+	return nil
+}
+
+// 待投递的邮件 html和text至少有一个
+type message struct {
+	id          string // Message-ID 用于关联退信等回执
+	from        string
+	fromName    string
+	to          string
+	subject     string
+	text        string
+	html        string
+	template    string
+	cc          []string
+	bcc         []string
+	replyTo     string
+	attachments []Attachment
+}
+
+// 投递结果
+type sendResult struct {
+	provider          string
+	providerMessageID string
+}
+
+// 邮件投递后端 smtp/api/log/file
+type sender interface {
+	send(msg *message) (*sendResult, error)
+}
+
+func (m *mailer) Send(to, subject, body string, opts ...Option) error {
+	return m.deliver(&message{
+		to:      to,
+		subject: subject,
+		text:    body,
+	}, opts)
 }
 
-func (m *mailer) Send(to, subject, body string) error {
-	msg := gomail.NewMessage()
-	msg.SetAddressHeader("From", config.From, config.FromName)
-	msg.SetHeader("To", to)
-	msg.SetHeader("Subject", subject)
-	msg.SetBody("text/plain", body)
+func (m *mailer) SendHTML(to, subject, htmlBody string, opts ...Option) error {
+	return m.deliver(&message{
+		to:      to,
+		subject: subject,
+		html:    htmlBody,
+	}, opts)
+}
 
-	// 如果设置了抄送邮箱，则添加CC头
-	if config.CC != "" {
-		msg.SetHeader("Cc", config.CC)
+func (m *mailer) SendTemplate(to, name, locale string, data any, opts ...Option) error {
+	rendered, err := m.templates.Render(name, locale, data)
+	if err != nil {
+		return errors.WithMessagef(err, "渲染邮件模板%s失败", name)
 	}
 
-	return errors.WithStack(m.dialer.DialAndSend(msg))
+	return m.deliver(&message{
+		to:       to,
+		subject:  rendered.Subject,
+		text:     rendered.Text,
+		html:     rendered.HTML,
+		template: name,
+	}, opts)
 }
 
-func (m *mailer) SendHTML(to, subject, htmlBody string) error {
-	msg := gomail.NewMessage()
-	msg.SetAddressHeader("From", config.From, config.FromName)
-	msg.SetHeader("To", to)
-	msg.SetHeader("Subject", subject)
-	msg.SetBody("text/html", htmlBody)
+// deliver 投递邮件并发布投递结果事件 供投递记录订阅
+func (m *mailer) deliver(msg *message, opts []Option) error {
+	for _, opt := range opts {
+		opt(msg)
+	}
+
+	id, err := newMessageID(m.cfg.From)
+	if err != nil {
+		return err
+	}
+	msg.id = id
+	msg.from = m.cfg.From
+	msg.fromName = m.cfg.FromName
+
+	ctx := context.Background()
+	res, err := m.sender.send(msg)
+	if err != nil {
+		m.bus.Publish(ctx, eventbus.EmailFailed{
+			MessageID:  msg.id,
+			Recipient:  msg.to,
+			Subject:    msg.subject,
+			Template:   msg.template,
+			Error:      err.Error(),
+			OccurredAt: time.Now(),
+		})
+		return err
+	}
 
-	// 如果设置了抄送邮箱，则添加CC头
-	if config.CC != "" {
-		msg.SetHeader("Cc", config.CC)
+	m.bus.Publish(ctx, eventbus.EmailSent{
+		MessageID:         msg.id,
+		Provider:          res.provider,
+		ProviderMessageID: res.providerMessageID,
+		Recipient:         msg.to,
+		Subject:           msg.subject,
+		Template:          msg.template,
+		OccurredAt:        time.Now(),
+	})
+	return nil
+}
+
+// newMessageID 生成 <uuid@发件域名> 形式的 Message-ID
+func newMessageID(from string) (string, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", errors.WithStack(err)
 	}
 
-	return errors.WithStack(m.dialer.DialAndSend(msg))
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 && i < len(from)-1 {
+		domain = from[i+1:]
+	}
+	return fmt.Sprintf("<%s@%s>", id.String(), domain), nil
 }
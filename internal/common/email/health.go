@@ -0,0 +1,42 @@
+package email
+
+import (
+	"context"
+	"sync"
+
+	"sass-scaffold/internal/common/health"
+)
+
+var healthOnce sync.Once
+
+// registerHealth 注册SMTP可选检查项 热加载替换实例后检查当前实例
+func registerHealth() {
+	healthOnce.Do(func() {
+		health.RegisterOptional(health.NewChecker("smtp", func(ctx context.Context) error {
+			m := instance.Load()
+			if m == nil {
+				return nil
+			}
+			return checkSMTP(m.sender)
+		}))
+	})
+}
+
+// checkSMTP 未使用SMTP投递时直接通过
+func checkSMTP(s sender) error {
+	switch s := s.(type) {
+	case *smtpSender:
+		conn, err := s.dialer.Dial()
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case *failoverSender:
+		for _, sub := range s.senders {
+			if err := checkSMTP(sub); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -1,73 +1,144 @@
 ﻿package email
 
 import (
-	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
 	"gopkg.in/gomail.v2"
-	"os"
-	"strconv"
+	"io"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/eventbus"
+	"strings"
+	"sync/atomic"
 )
 
-type mailerConfig struct {
-	Host     string
-	Port     int
-	Username string
-	Password string
-	From     string
-	FromName string
-	CC       string
-}
+// 邮件投递方式
+const (
+	DriverSMTP = "smtp"
+	DriverAPI  = "api"
+	DriverLog  = "log"
+	DriverFile = "file"
+)
 
 type mailer struct {
-	dialer *gomail.Dialer
+	cfg       config.EmailConfig
+	sender    sender
+	templates *Registry
+	bus       *eventbus.Bus
 }
 
-var instance Mailer
+// 热加载时整体替换 发送中的邮件继续使用旧实例
+var instance atomic.Pointer[mailer]
 
-var config mailerConfig
+// 当前生效的邮件配置
+func currentConfig() config.EmailConfig {
+	if m := instance.Load(); m != nil {
+		return m.cfg
+	}
+	return config.EmailConfig{}
+}
 
-func init() {
-	if err := UpdateConfig(); err != nil {
-		panic(err)
+func validateConfig(cfg config.EmailConfig, drivers []string) error {
+	for _, driver := range drivers {
+		switch driver {
+		case DriverSMTP:
+			// 校验必填项
+			if cfg.Host == "" ||
+				cfg.Port == 0 ||
+				cfg.Username == "" ||
+				cfg.Password == "" ||
+				cfg.From == "" {
+				return errors.New("email config: smtp配置缺失，必填项不能为空")
+			}
+		case DriverAPI:
+			if cfg.APIURL == "" ||
+				cfg.APIKey == "" ||
+				cfg.From == "" {
+				return errors.New("email config: api配置缺失，必填项不能为空")
+			}
+		case DriverLog, DriverFile:
+		default:
+			return errors.Errorf("email config: 不支持的EMAIL_DRIVER %s", driver)
+		}
 	}
-	if err := validateEnv(); err != nil {
-		panic(err)
+	if cfg.SMTPPoolSize < 1 {
+		return errors.New("email config: EMAIL_SMTP_POOL_SIZE无效")
 	}
+	return nil
 }
 
-func validateEnv() error {
-	// 校验必填项
-	if config.Host == "" ||
-		config.Port == 0 ||
-		config.Username == "" ||
-		config.Password == "" ||
-		config.From == "" {
-		return errors.New("email config: 环境变量缺失，必填项不能为空")
+// Init 按配置创建全局邮件客户端 可重复调用以替换配置
+func Init(cfg config.EmailConfig) error {
+	// 未指定投递方式时 配置了SMTP则使用SMTP 否则只记录日志 保证本地开发无需SMTP即可启动
+	// 多个投递方式以逗号分隔 如 api,smtp 表示api失败时转由smtp投递
+	driverStr := cfg.Driver
+	if driverStr == "" {
+		if cfg.Host != "" {
+			driverStr = DriverSMTP
+		} else {
+			driverStr = DriverLog
+		}
+	}
+	var drivers []string
+	for _, d := range strings.Split(driverStr, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			drivers = append(drivers, d)
+		}
+	}
+
+	if err := validateConfig(cfg, drivers); err != nil {
+		return err
+	}
+
+	senders := make([]sender, 0, len(drivers))
+	for _, driver := range drivers {
+		senders = append(senders, newSender(cfg, driver))
 	}
+
+	var s sender = &failoverSender{names: drivers, senders: senders}
+	if len(senders) == 1 {
+		s = senders[0]
+	}
+
+	old := instance.Swap(&mailer{
+		cfg:       cfg,
+		sender:    s,
+		templates: GetRegistryInstance(),
+		bus:       eventbus.GetBusInstance(),
+	})
+
+	// 关闭旧实例的空闲SMTP连接 发送中的连接用完后关闭
+	if old != nil {
+		_ = old.close()
+	}
+
+	registerHealth()
 	return nil
 }
 
-func UpdateConfig() error {
-	_ = godotenv.Load()
-	portStr := os.Getenv("EMAIL_PORT")
-	port, err := strconv.Atoi(portStr)
-	if err != nil {
-		return err
+func newSender(cfg config.EmailConfig, driver string) sender {
+	switch driver {
+	case DriverSMTP:
+		dialer := gomail.NewDialer(cfg.Host, cfg.Port, cfg.Username, cfg.Password.Value())
+		return newSMTPSender(dialer, cfg.SMTPPoolSize)
+	case DriverAPI:
+		return newAPISender(cfg.APIURL, cfg.APIKey.Value())
+	case DriverFile:
+		return &fileSender{dir: cfg.FileDir}
+	default:
+		return &logSender{}
 	}
+}
 
-	config = mailerConfig{
-		Host:     os.Getenv("EMAIL_HOST"),
-		Port:     port,
-		Username: os.Getenv("EMAIL_USERNAME"),
-		Password: os.Getenv("EMAIL_PASSWORD"),
-		From:     os.Getenv("EMAIL_FROM"),
-		FromName: os.Getenv("EMAIL_FROM_NAME"),
-		CC:       os.Getenv("EMAIL_CC"),
+// Close 关闭连接池中的SMTP连接
+func Close() error {
+	if m := instance.Load(); m != nil {
+		return m.close()
 	}
+	return nil
+}
 
-	dialer := gomail.NewDialer(config.Host, config.Port, config.Username, config.Password)
-	instance = &mailer{
-		dialer: dialer,
+func (m *mailer) close() error {
+	if c, ok := m.sender.(io.Closer); ok {
+		return c.Close()
 	}
 	return nil
 }
@@ -0,0 +1,43 @@
+package email
+
+// Option 单封邮件的可选项
+type Option func(msg *message)
+
+// Attachment 邮件附件
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// WithCC 抄送
+func WithCC(addrs ...string) Option {
+	return func(msg *message) {
+		msg.cc = append(msg.cc, addrs...)
+	}
+}
+
+// WithBCC 密送
+func WithBCC(addrs ...string) Option {
+	return func(msg *message) {
+		msg.bcc = append(msg.bcc, addrs...)
+	}
+}
+
+// WithReplyTo 回复地址
+func WithReplyTo(addr string) Option {
+	return func(msg *message) {
+		msg.replyTo = addr
+	}
+}
+
+// WithAttachment 添加附件 contentType为空时按文件名推断
+func WithAttachment(filename, contentType string, content []byte) Option {
+	return func(msg *message) {
+		msg.attachments = append(msg.attachments, Attachment{
+			Filename:    filename,
+			ContentType: contentType,
+			Content:     content,
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package email
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+// RegisterPreview 注册邮件模板预览接口 仅在开发模式(gin.DebugMode)下生效
+//
+//	GET /dev/emails                                 模板列表
+//	GET /dev/emails/:name?locale=en&format=text     使用sample.json渲染模板
+func RegisterPreview(r *gin.RouterGroup) {
+	if gin.Mode() != gin.DebugMode {
+		return
+	}
+
+	g := r.Group("/dev/emails")
+	{
+		g.GET("", listTemplates)
+		g.GET("/:name", previewTemplate)
+	}
+}
+
+func listTemplates(ctx *gin.Context) {
+	response.Success(ctx, gin.H{
+		"templates": registry.Names(),
+		"locales":   supportedLocales,
+	})
+}
+
+func previewTemplate(ctx *gin.Context) {
+	name := ctx.Param("name")
+	if !registry.Has(name) {
+		ctx.String(http.StatusNotFound, "邮件模板%s不存在", name)
+		return
+	}
+
+	var data any
+	sample, err := registry.Sample(name)
+	if err != nil {
+		ctx.String(http.StatusInternalServerError, "%+v", err)
+		return
+	}
+	if sample != nil {
+		if err := json.Unmarshal(sample, &data); err != nil {
+			ctx.String(http.StatusInternalServerError, "sample.json格式错误: %v", err)
+			return
+		}
+	}
+
+	rendered, err := registry.Render(name, ctx.DefaultQuery("locale", DefaultLocale), data)
+	if err != nil {
+		ctx.String(http.StatusInternalServerError, "%+v", err)
+		return
+	}
+
+	ctx.Header("X-Email-Subject", rendered.Subject)
+	if ctx.Query("format") == "text" || rendered.HTML == "" {
+		ctx.String(http.StatusOK, "%s", rendered.Text)
+		return
+	}
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(rendered.HTML))
+}
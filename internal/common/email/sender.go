@@ -0,0 +1,212 @@
+package email
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/gomail.v2"
+
+	"sass-scaffold/internal/common/logger"
+)
+
+func newGomailMessage(msg *message) *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetAddressHeader("From", msg.from, msg.fromName)
+	m.SetHeader("To", msg.to)
+	m.SetHeader("Subject", msg.subject)
+	m.SetHeader("Message-ID", msg.id)
+
+	if len(msg.cc) > 0 {
+		m.SetHeader("Cc", msg.cc...)
+	}
+	if len(msg.bcc) > 0 {
+		m.SetHeader("Bcc", msg.bcc...)
+	}
+	if msg.replyTo != "" {
+		m.SetHeader("Reply-To", msg.replyTo)
+	}
+
+	// 同时有纯文本和html时 以html作为首选展示
+	switch {
+	case msg.text != "" && msg.html != "":
+		m.SetBody("text/plain", msg.text)
+		m.AddAlternative("text/html", msg.html)
+	case msg.html != "":
+		m.SetBody("text/html", msg.html)
+	default:
+		m.SetBody("text/plain", msg.text)
+	}
+
+	for _, a := range msg.attachments {
+		settings := []gomail.FileSetting{
+			gomail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(a.Content)
+				return err
+			}),
+		}
+		if a.ContentType != "" {
+			settings = append(settings, gomail.SetHeader(map[string][]string{
+				"Content-Type": {a.ContentType},
+			}))
+		}
+		m.Attach(a.Filename, settings...)
+	}
+	return m
+}
+
+// smtpSender 通过SMTP投递 复用连接避免每封邮件重新握手
+type smtpSender struct {
+	dialer *gomail.Dialer
+	pool   chan gomail.SendCloser
+	closed atomic.Bool
+}
+
+func newSMTPSender(dialer *gomail.Dialer, poolSize int) *smtpSender {
+	return &smtpSender{
+		dialer: dialer,
+		pool:   make(chan gomail.SendCloser, poolSize),
+	}
+}
+
+// get 优先取空闲连接 reused表示连接来自连接池
+func (s *smtpSender) get() (conn gomail.SendCloser, reused bool, err error) {
+	select {
+	case conn = <-s.pool:
+		return conn, true, nil
+	default:
+		conn, err = s.dialer.Dial()
+		return conn, false, errors.WithStack(err)
+	}
+}
+
+// put 归还连接 连接池已满或已关闭时直接关闭
+func (s *smtpSender) put(conn gomail.SendCloser) {
+	if s.closed.Load() {
+		_ = conn.Close()
+		return
+	}
+	select {
+	case s.pool <- conn:
+	default:
+		_ = conn.Close()
+	}
+}
+
+func (s *smtpSender) send(msg *message) (*sendResult, error) {
+	m := newGomailMessage(msg)
+
+	for {
+		conn, reused, err := s.get()
+		if err != nil {
+			return nil, err
+		}
+
+		err = gomail.Send(conn, m)
+		if err == nil {
+			s.put(conn)
+			return &sendResult{provider: DriverSMTP, providerMessageID: msg.id}, nil
+		}
+		_ = conn.Close()
+
+		// 空闲连接可能已被服务器断开 换新连接重试
+		if !reused {
+			return nil, errors.WithStack(err)
+		}
+	}
+}
+
+func (s *smtpSender) Close() error {
+	s.closed.Store(true)
+	for {
+		select {
+		case conn := <-s.pool:
+			_ = conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// logSender 只记录日志 用于本地开发
+// 正文可能包含邀请链接等敏感内容 只记录脱敏后的收件人与主题 查看正文请使用file模式
+type logSender struct{}
+
+func (s *logSender) send(msg *message) (*sendResult, error) {
+	zap.L().Info("邮件未实际发送(log模式)",
+		zap.String("message_id", msg.id),
+		zap.String("to", logger.Redact(msg.to)),
+		zap.Int("cc", len(msg.cc)),
+		zap.Int("bcc", len(msg.bcc)),
+		zap.String("subject", msg.subject),
+		zap.String("template", msg.template),
+		zap.Int("text_size", len(msg.text)),
+		zap.Int("html_size", len(msg.html)),
+		zap.Int("attachments", len(msg.attachments)),
+	)
+	return &sendResult{provider: DriverLog, providerMessageID: msg.id}, nil
+}
+
+// fileSender 将邮件写为.eml文件 可直接用邮件客户端打开查看
+type fileSender struct {
+	dir string
+}
+
+func (s *fileSender) send(msg *message) (*sendResult, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), msg.to)
+	f, err := os.Create(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	if _, err := newGomailMessage(msg).WriteTo(f); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	zap.L().Info("邮件已写入文件(file模式)", zap.String("to", msg.to), zap.String("file", f.Name()))
+	return &sendResult{provider: DriverFile, providerMessageID: msg.id}, nil
+}
+
+// failoverSender 按顺序尝试各后端 直到有一个投递成功
+type failoverSender struct {
+	names   []string
+	senders []sender
+}
+
+func (s *failoverSender) send(msg *message) (*sendResult, error) {
+	errs := make([]string, 0, len(s.senders))
+	for i, backend := range s.senders {
+		res, err := backend.send(msg)
+		if err == nil {
+			return res, nil
+		}
+
+		zap.L().Warn("邮件后端投递失败,尝试下一个后端",
+			zap.String("backend", s.names[i]),
+			zap.String("message_id", msg.id),
+			zap.Error(err),
+		)
+		errs = append(errs, fmt.Sprintf("%s: %v", s.names[i], err))
+	}
+	return nil, errors.Errorf("所有邮件后端投递失败: %s", strings.Join(errs, "; "))
+}
+
+func (s *failoverSender) Close() error {
+	for _, backend := range s.senders {
+		if c, ok := backend.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 支持的邮件语言
+const (
+	LocaleZH = "zh"
+	LocaleEN = "en"
+
+	DefaultLocale = LocaleZH
+)
+
+var supportedLocales = []string{LocaleZH, LocaleEN}
+
+// 模板目录结构:
+//
+//	layouts/base.html, layouts/base.txt  公共布局
+//	partials/*.html                      html片段
+//	<name>/<locale>.html                 定义"content"
+//	<name>/<locale>.txt                  定义"subject"和"content"
+//	<name>/sample.json                   预览用示例数据(可选)
+//
+//go:embed templates
+var templateFS embed.FS
+
+// View 模板渲染时的根数据 业务数据位于.Data
+type View struct {
+	AppName string
+	Locale  string
+	Year    int
+	Data    any
+}
+
+// Link 拼接邮件中指向前端页面的链接 path需以/开头
+func Link(path string) string {
+	return strings.TrimRight(currentConfig().AppURL, "/") + path
+}
+
+// Rendered 渲染结果
+type Rendered struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type localized struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Registry 邮件模板注册表 启动时一次性解析全部模板
+type Registry struct {
+	fsys      fs.FS
+	templates map[string]map[string]*localized // name -> locale -> 模板
+}
+
+var registry = mustNewRegistry()
+
+// 模板中可用的辅助函数
+var templateFuncs = map[string]any{
+	// dict 用于向片段传递多个参数: {{template "button" (dict "URL" .Data.URL "Text" "确认")}}
+	"dict": func(pairs ...any) (map[string]any, error) {
+		if len(pairs)%2 != 0 {
+			return nil, errors.New("dict参数必须成对出现")
+		}
+		m := make(map[string]any, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			key, ok := pairs[i].(string)
+			if !ok {
+				return nil, errors.Errorf("dict的键必须是字符串: %v", pairs[i])
+			}
+			m[key] = pairs[i+1]
+		}
+		return m, nil
+	},
+}
+
+// GetRegistryInstance 获取全局邮件模板注册表
+func GetRegistryInstance() *Registry {
+	return registry
+}
+
+func mustNewRegistry() *Registry {
+	sub, err := fs.Sub(templateFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+	r, err := NewRegistry(sub)
+	if err != nil {
+		panic(errors.WithMessage(err, "邮件模板解析失败"))
+	}
+	return r
+}
+
+// NewRegistry 从文件系统加载模板 目录结构见templateFS
+func NewRegistry(fsys fs.FS) (*Registry, error) {
+	htmlBase, err := htmltemplate.New("base").
+		Option("missingkey=error").
+		Funcs(htmltemplate.FuncMap(templateFuncs)).
+		ParseFS(fsys, "layouts/*.html", "partials/*.html")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	textBase, err := texttemplate.New("base").
+		Option("missingkey=error").
+		Funcs(texttemplate.FuncMap(templateFuncs)).
+		ParseFS(fsys, "layouts/*.txt")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	r := &Registry{
+		fsys:      fsys,
+		templates: make(map[string]map[string]*localized),
+	}
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || name == "layouts" || name == "partials" {
+			continue
+		}
+
+		for _, locale := range supportedLocales {
+			t, err := parseLocalized(fsys, htmlBase, textBase, name, locale)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "模板%s(%s)", name, locale)
+			}
+			if t == nil {
+				continue
+			}
+			if r.templates[name] == nil {
+				r.templates[name] = make(map[string]*localized)
+			}
+			r.templates[name][locale] = t
+		}
+
+		if r.templates[name][DefaultLocale] == nil {
+			return nil, errors.Errorf("模板%s缺少默认语言%s", name, DefaultLocale)
+		}
+	}
+
+	return r, nil
+}
+
+func parseLocalized(fsys fs.FS, htmlBase *htmltemplate.Template, textBase *texttemplate.Template, name, locale string) (*localized, error) {
+	htmlFile := path.Join(name, locale+".html")
+	textFile := path.Join(name, locale+".txt")
+
+	_, htmlErr := fs.Stat(fsys, htmlFile)
+	_, textErr := fs.Stat(fsys, textFile)
+	if htmlErr != nil && textErr != nil {
+		return nil, nil
+	}
+	// 主题定义在纯文本模板中 因此纯文本版本必须存在
+	if textErr != nil {
+		return nil, errors.Errorf("缺少%s", textFile)
+	}
+
+	t := new(localized)
+
+	textBaseClone, err := textBase.Clone()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if t.text, err = textBaseClone.ParseFS(fsys, textFile); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if htmlErr == nil {
+		htmlBaseClone, err := htmlBase.Clone()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if t.html, err = htmlBaseClone.ParseFS(fsys, htmlFile); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return t, nil
+}
+
+// Names 已注册的模板名称
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.templates))
+	for name := range r.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Has 模板是否存在
+func (r *Registry) Has(name string) bool {
+	_, ok := r.templates[name]
+	return ok
+}
+
+// Render 渲染模板 目标语言不存在时回退到默认语言
+func (r *Registry) Render(name, locale string, data any) (*Rendered, error) {
+	variants, ok := r.templates[name]
+	if !ok {
+		return nil, errors.Errorf("邮件模板%s不存在", name)
+	}
+
+	locale = NormalizeLocale(locale)
+	t, ok := variants[locale]
+	if !ok {
+		locale = DefaultLocale
+		t = variants[locale]
+	}
+
+	view := &View{
+		AppName: currentConfig().FromName,
+		Locale:  locale,
+		Year:    time.Now().Year(),
+		Data:    data,
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", view); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "layout", view); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if t.html != nil {
+		if err := t.html.ExecuteTemplate(&html, "layout", view); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return &Rendered{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    strings.TrimSpace(text.String()),
+	}, nil
+}
+
+// Sample 读取模板的示例数据 用于预览
+func (r *Registry) Sample(name string) ([]byte, error) {
+	data, err := fs.ReadFile(r.fsys, path.Join(name, "sample.json"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// NormalizeLocale 将zh-CN、en_US或Accept-Language等形式归一为支持的语言 无法识别时返回默认语言
+func NormalizeLocale(locale string) string {
+	for _, tag := range strings.Split(locale, ",") {
+		tag = strings.TrimSpace(strings.SplitN(tag, ";", 2)[0])
+		if i := strings.IndexAny(tag, "-_"); i >= 0 {
+			tag = tag[:i]
+		}
+		tag = strings.ToLower(tag)
+		for _, l := range supportedLocales {
+			if tag == l {
+				return l
+			}
+		}
+	}
+	return DefaultLocale
+}
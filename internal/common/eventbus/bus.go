@@ -0,0 +1,181 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/logger"
+)
+
+// Event 领域事件 EventName 需为值接收者 以便通过零值取得事件名
+type Event interface {
+	EventName() string
+}
+
+// Handler 事件处理函数
+type Handler func(ctx context.Context, e Event) error
+
+type subscriber struct {
+	id      uint64
+	handler Handler
+	async   bool
+}
+
+// Bus 进程内事件总线
+type Bus struct {
+	mu     sync.RWMutex
+	subs   map[string][]subscriber
+	seq    uint64
+	wg     sync.WaitGroup
+	closed bool
+}
+
+func New() *Bus {
+	return &Bus{
+		subs: make(map[string][]subscriber),
+	}
+}
+
+var instance = New()
+
+// GetBusInstance 获取全局事件总线实例
+func GetBusInstance() *Bus {
+	return instance
+}
+
+// Subscribe 注册同步订阅者 在发布者的goroutine中按注册顺序执行 返回取消订阅的函数
+func (b *Bus) Subscribe(name string, h Handler) (unsubscribe func()) {
+	return b.subscribe(name, subscriber{handler: h})
+}
+
+// SubscribeAsync 注册异步订阅者 每次发布都在独立goroutine中执行 返回取消订阅的函数
+func (b *Bus) SubscribeAsync(name string, h Handler) (unsubscribe func()) {
+	return b.subscribe(name, subscriber{handler: h, async: true})
+}
+
+func (b *Bus) subscribe(name string, s subscriber) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	s.id = b.seq
+	b.subs[name] = append(b.subs[name], s)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { b.unsubscribe(name, s.id) })
+	}
+}
+
+// unsubscribe 复制切片后移除 不影响Publish中已取得的订阅者列表
+func (b *Bus) unsubscribe(name string, id uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs := b.subs[name]
+	for i, s := range subs {
+		if s.id == id {
+			b.subs[name] = append(subs[:i:i], subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Publish 发布事件 订阅者的错误和panic只记录日志 不会影响发布方
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	name := e.EventName()
+
+	b.mu.RLock()
+	subs := b.subs[name]
+	b.mu.RUnlock()
+
+	for _, s := range subs {
+		if !s.async {
+			b.dispatch(ctx, name, s.handler, e)
+			continue
+		}
+
+		if !b.track() {
+			logger.FromContext(ctx).Named("eventbus").Warn("事件总线已关闭,丢弃异步事件", zap.String("event", name))
+			continue
+		}
+
+		go func(h Handler) {
+			defer b.wg.Done()
+			// 异步订阅者不随请求取消
+			b.dispatch(context.WithoutCancel(ctx), name, h, e)
+		}(s.handler)
+	}
+}
+
+// track 登记一个异步订阅者 与Close持有同一把锁 保证wg.Add不会与wg.Wait并发
+func (b *Bus) track() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return false
+	}
+	b.wg.Add(1)
+	return true
+}
+
+func (b *Bus) dispatch(ctx context.Context, name string, h Handler, e Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.FromContext(ctx).Named("eventbus").Error("事件订阅者panic",
+				zap.String("event", name),
+				zap.Any("panic", r),
+				zap.ByteString("stack", debug.Stack()),
+			)
+		}
+	}()
+
+	if err := h(ctx, e); err != nil {
+		logger.FromContext(ctx).Named("eventbus").Error("事件订阅者处理失败", zap.String("event", name), zap.Error(err))
+	}
+}
+
+// Close 停止接收新的异步事件 并等待处理中的异步订阅者完成
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.WithMessage(ctx.Err(), "等待异步订阅者超时")
+	}
+}
+
+// On 注册强类型的同步订阅者
+func On[T Event](b *Bus, h func(ctx context.Context, e T) error) (unsubscribe func()) {
+	var zero T
+	return b.Subscribe(zero.EventName(), typed(h))
+}
+
+// OnAsync 注册强类型的异步订阅者
+func OnAsync[T Event](b *Bus, h func(ctx context.Context, e T) error) (unsubscribe func()) {
+	var zero T
+	return b.SubscribeAsync(zero.EventName(), typed(h))
+}
+
+func typed[T Event](h func(ctx context.Context, e T) error) Handler {
+	return func(ctx context.Context, e Event) error {
+		te, ok := e.(T)
+		if !ok {
+			return fmt.Errorf("事件类型不匹配: %T", e)
+		}
+		return h(ctx, te)
+	}
+}
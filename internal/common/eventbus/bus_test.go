@@ -0,0 +1,121 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testEvent struct{ n int }
+
+func (testEvent) EventName() string { return "test.event" }
+
+func TestSyncSubscribersRunInOrder(t *testing.T) {
+	b := New()
+	var got []int
+	On(b, func(_ context.Context, e testEvent) error { got = append(got, e.n); return nil })
+	On(b, func(_ context.Context, e testEvent) error { got = append(got, e.n*10); return nil })
+
+	b.Publish(context.Background(), testEvent{n: 1})
+	// 同步订阅者在Publish返回前执行完毕
+	if len(got) != 2 || got[0] != 1 || got[1] != 10 {
+		t.Fatalf("got=%v 期望[1 10]", got)
+	}
+}
+
+func TestAsyncSubscribersRunAfterPublish(t *testing.T) {
+	b := New()
+	release := make(chan struct{})
+	var done atomic.Bool
+	OnAsync(b, func(ctx context.Context, _ testEvent) error {
+		<-release
+		if ctx.Err() != nil {
+			t.Error("异步订阅者不应随发布方的ctx取消")
+		}
+		done.Store(true)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Publish(ctx, testEvent{})
+	cancel()
+	if done.Load() {
+		t.Fatal("异步订阅者不应阻塞Publish")
+	}
+
+	close(release)
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !done.Load() {
+		t.Fatal("Close应等待异步订阅者完成")
+	}
+}
+
+func TestSubscriberFailuresAreIsolated(t *testing.T) {
+	b := New()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	var calls atomic.Int32
+	On(b, func(context.Context, testEvent) error { panic("boom") })
+	On(b, func(context.Context, testEvent) error { return errors.New("failed") })
+	OnAsync(b, func(context.Context, testEvent) error { panic("async boom") })
+	On(b, func(context.Context, testEvent) error { calls.Add(1); return nil })
+	OnAsync(b, func(context.Context, testEvent) error { defer wg.Done(); calls.Add(1); return nil })
+
+	b.Publish(context.Background(), testEvent{})
+	wg.Wait()
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("其余订阅者执行了%d次 期望2次", n)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	b := New()
+	var calls atomic.Int32
+	unsubscribe := On(b, func(context.Context, testEvent) error { calls.Add(1); return nil })
+	unsubscribeAsync := OnAsync(b, func(context.Context, testEvent) error { calls.Add(1); return nil })
+
+	unsubscribe()
+	unsubscribe()
+	unsubscribeAsync()
+	b.Publish(context.Background(), testEvent{})
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("取消订阅后仍执行了%d次", n)
+	}
+}
+
+func TestCloseDropsNewAsyncEvents(t *testing.T) {
+	b := New()
+	var calls atomic.Int32
+	OnAsync(b, func(context.Context, testEvent) error { calls.Add(1); return nil })
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Publish(context.Background(), testEvent{})
+	time.Sleep(10 * time.Millisecond)
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("关闭后仍处理了%d个异步事件", n)
+	}
+}
+
+func TestCloseTimeout(t *testing.T) {
+	b := New()
+	release := make(chan struct{})
+	defer close(release)
+	OnAsync(b, func(context.Context, testEvent) error { <-release; return nil })
+	b.Publish(context.Background(), testEvent{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Close(ctx); err == nil {
+		t.Fatal("订阅者未完成时Close应超时返回错误")
+	}
+}
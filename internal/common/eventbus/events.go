@@ -0,0 +1,160 @@
+package eventbus
+
+import "time"
+
+// 事件名称 同时作为对外的事件类型标识
+const (
+	NameUserRegistered = "user.registered"
+	NameTeamCreated    = "team.created"
+	NameMemberInvited  = "team.member_invited"
+
+	NameUserLoggedIn      = "user.logged_in"
+	NameTokenRefreshed    = "user.token_refreshed"
+	NameProfileUpdated    = "user.profile_updated"
+	NameMemberJoined      = "team.member_joined"
+	NameMemberRoleChanged = "team.member_role_changed"
+	NameMemberRemoved     = "team.member_removed"
+
+	NameEmailSent   = "email.sent"
+	NameEmailFailed = "email.failed"
+)
+
+// RequestMeta 触发事件的请求来源 由传输层填充 用于审计
+type RequestMeta struct {
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
+	// 国家代码 由CDN请求头获得 可能为空
+	Country string `json:"-"`
+}
+
+// Change 字段变更前后的值
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// UserRegistered 用户注册
+type UserRegistered struct {
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	Username   string    `json:"username"`
+	Provider   string    `json:"provider"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (UserRegistered) EventName() string { return NameUserRegistered }
+
+// TeamCreated 团队创建
+type TeamCreated struct {
+	TeamID     string    `json:"team_id"`
+	OwnerID    string    `json:"owner_id"`
+	Name       string    `json:"name"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (TeamCreated) EventName() string { return NameTeamCreated }
+
+// MemberInvited 团队成员邀请
+type MemberInvited struct {
+	TeamID     string      `json:"team_id"`
+	OwnerID    string      `json:"owner_id"`
+	UserID     string      `json:"user_id"`
+	Role       string      `json:"role"`
+	InvitedBy  string      `json:"invited_by"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (MemberInvited) EventName() string { return NameMemberInvited }
+
+// UserLoggedIn 用户登录
+type UserLoggedIn struct {
+	UserID     string      `json:"user_id"`
+	Provider   string      `json:"provider"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (UserLoggedIn) EventName() string { return NameUserLoggedIn }
+
+// TokenRefreshed 刷新访问令牌
+type TokenRefreshed struct {
+	UserID     string      `json:"user_id"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (TokenRefreshed) EventName() string { return NameTokenRefreshed }
+
+// ProfileUpdated 用户资料更新 Changes 只包含发生变化的字段
+type ProfileUpdated struct {
+	UserID     string            `json:"user_id"`
+	Changes    map[string]Change `json:"changes"`
+	Meta       RequestMeta       `json:"-"`
+	OccurredAt time.Time         `json:"occurred_at"`
+}
+
+func (ProfileUpdated) EventName() string { return NameProfileUpdated }
+
+// MemberJoined 被邀请的成员接受邀请加入团队
+type MemberJoined struct {
+	TeamID     string      `json:"team_id"`
+	OwnerID    string      `json:"owner_id"`
+	UserID     string      `json:"user_id"`
+	Role       string      `json:"role"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (MemberJoined) EventName() string { return NameMemberJoined }
+
+// MemberRoleChanged 团队成员角色变更
+type MemberRoleChanged struct {
+	TeamID     string      `json:"team_id"`
+	OwnerID    string      `json:"owner_id"`
+	UserID     string      `json:"user_id"`
+	OldRole    string      `json:"old_role"`
+	NewRole    string      `json:"new_role"`
+	ChangedBy  string      `json:"changed_by"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (MemberRoleChanged) EventName() string { return NameMemberRoleChanged }
+
+// MemberRemoved 团队成员移除
+type MemberRemoved struct {
+	TeamID     string      `json:"team_id"`
+	OwnerID    string      `json:"owner_id"`
+	UserID     string      `json:"user_id"`
+	RemovedBy  string      `json:"removed_by"`
+	Meta       RequestMeta `json:"-"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (MemberRemoved) EventName() string { return NameMemberRemoved }
+
+// EmailSent 邮件已被投递后端接收
+type EmailSent struct {
+	MessageID         string    `json:"message_id"`
+	Provider          string    `json:"provider"`
+	ProviderMessageID string    `json:"provider_message_id"`
+	Recipient         string    `json:"recipient"`
+	Subject           string    `json:"subject"`
+	Template          string    `json:"template,omitempty"`
+	OccurredAt        time.Time `json:"occurred_at"`
+}
+
+func (EmailSent) EventName() string { return NameEmailSent }
+
+// EmailFailed 所有投递后端均失败
+type EmailFailed struct {
+	MessageID  string    `json:"message_id"`
+	Recipient  string    `json:"recipient"`
+	Subject    string    `json:"subject"`
+	Template   string    `json:"template,omitempty"`
+	Error      string    `json:"error"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (EmailFailed) EventName() string { return NameEmailFailed }
@@ -0,0 +1,16 @@
+package eventbus
+
+import "context"
+
+type metaKey struct{}
+
+// WithMeta 由传输层将请求来源放入ctx 业务层发布事件时通过 MetaFrom 取出
+func WithMeta(ctx context.Context, meta RequestMeta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// MetaFrom 取出请求来源 非请求触发时返回零值
+func MetaFrom(ctx context.Context) RequestMeta {
+	meta, _ := ctx.Value(metaKey{}).(RequestMeta)
+	return meta
+}
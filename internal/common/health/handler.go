@@ -0,0 +1,43 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+// Liveness 进程存活即返回200 不检查依赖 避免依赖故障导致进程被反复重启
+func Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": StatusOK})
+}
+
+// Readiness 依赖全部可用时返回200 否则返回503
+// 接口无需鉴权 只返回各检查项的状态与耗时 不暴露依赖的地址与错误信息
+func Readiness(c *gin.Context) {
+	report := Ready(c.Request.Context())
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, report.Public())
+}
+
+// Details 返回各检查项的详细结果 需挂载在管理员分组下
+func Details(c *gin.Context) {
+	response.Success(c, Ready(c.Request.Context()))
+}
+
+// RegisterRoutes 注册 /healthz 与 /readyz
+func RegisterRoutes(r gin.IRoutes) {
+	r.GET("/healthz", Liveness)
+	r.GET("/readyz", Readiness)
+}
+
+// RegisterAdminRoutes 注册就绪检查详情接口 调用方负责鉴权
+//
+//	GET /health  各检查项的状态、耗时与错误信息
+func RegisterAdminRoutes(r *gin.RouterGroup) {
+	r.GET("/health", Details)
+}
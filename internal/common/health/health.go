@@ -0,0 +1,208 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HealthChecker 依赖健康检查 模块通过Register注册
+type HealthChecker interface {
+	// Name 检查项名称 作为/readyz响应中的键
+	Name() string
+	// Check 依赖不可用时返回错误 需响应ctx超时
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc 使用函数实现HealthChecker
+type CheckerFunc struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+func NewChecker(name string, fn func(ctx context.Context) error) *CheckerFunc {
+	return &CheckerFunc{name: name, fn: fn}
+}
+
+func (c *CheckerFunc) Name() string {
+	return c.name
+}
+
+func (c *CheckerFunc) Check(ctx context.Context) error {
+	return c.fn(ctx)
+}
+
+const (
+	// 单个检查项的超时时间
+	checkTimeout = 3 * time.Second
+	// 检查结果的缓存时间 避免频繁探测时反复连接SMTP、查询Citus节点
+	cacheTTL = 5 * time.Second
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+type registration struct {
+	checker  HealthChecker
+	optional bool
+}
+
+var (
+	mu       sync.RWMutex
+	checkers []registration
+
+	// 开始优雅关闭后置为true 就绪检查直接失败 使负载均衡摘除实例
+	shuttingDown atomic.Bool
+
+	// cacheMu 同时保证并发请求只执行一轮检查
+	cacheMu    sync.Mutex
+	cached     *snapshot
+	cachedTime time.Time
+)
+
+// snapshot 一轮检查的结果 缓存期间只读
+type snapshot struct {
+	checks map[string]CheckResult
+	failed bool
+}
+
+// Register 注册必需的检查项 失败时实例不可就绪
+func Register(c HealthChecker) {
+	register(c, false)
+}
+
+// RegisterOptional 注册可选的检查项 失败只在响应中体现 不影响就绪
+func RegisterOptional(c HealthChecker) {
+	register(c, true)
+}
+
+func register(c HealthChecker, optional bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	checkers = append(checkers, registration{checker: c, optional: optional})
+}
+
+// SetShuttingDown 标记进程正在关闭
+func SetShuttingDown() {
+	shuttingDown.Store(true)
+}
+
+func IsShuttingDown() bool {
+	return shuttingDown.Load()
+}
+
+// CheckResult 单个检查项的结果
+type CheckResult struct {
+	Status   string  `json:"status"`
+	Optional bool    `json:"optional,omitempty"`
+	Latency  float64 `json:"latency_ms"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// Report 就绪检查报告 包含依赖的错误信息 只通过管理接口返回
+type Report struct {
+	Status       string                 `json:"status"`
+	ShuttingDown bool                   `json:"shutting_down,omitempty"`
+	Checks       map[string]CheckResult `json:"checks"`
+}
+
+// Ready 返回就绪报告 必需项全部通过且未在关闭中时为就绪
+// 检查结果缓存cacheTTL 关闭状态每次实时读取
+func Ready(ctx context.Context) *Report {
+	snap := load(ctx)
+	report := &Report{
+		Status:       StatusOK,
+		ShuttingDown: IsShuttingDown(),
+		Checks:       snap.checks,
+	}
+	if snap.failed || report.ShuttingDown {
+		report.Status = StatusFail
+	}
+	return report
+}
+
+// Public 去掉错误信息后的报告 可用于无需鉴权的接口
+func (r *Report) Public() *Report {
+	checks := make(map[string]CheckResult, len(r.Checks))
+	for name, res := range r.Checks {
+		res.Error = ""
+		checks[name] = res
+	}
+	return &Report{
+		Status:       r.Status,
+		ShuttingDown: r.ShuttingDown,
+		Checks:       checks,
+	}
+}
+
+func load(ctx context.Context) *snapshot {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if cached != nil && time.Since(cachedTime) < cacheTTL {
+		return cached
+	}
+	// 结果由多个请求共享 不随单个请求取消
+	cached = check(context.WithoutCancel(ctx))
+	cachedTime = time.Now()
+	return cached
+}
+
+// check 并发执行全部检查项
+func check(ctx context.Context) *snapshot {
+	mu.RLock()
+	regs := append([]registration(nil), checkers...)
+	mu.RUnlock()
+
+	results := make([]CheckResult, len(regs))
+	var wg sync.WaitGroup
+	for i, reg := range regs {
+		wg.Add(1)
+		go func(i int, reg registration) {
+			defer wg.Done()
+			results[i] = run(ctx, reg)
+		}(i, reg)
+	}
+	wg.Wait()
+
+	snap := &snapshot{checks: make(map[string]CheckResult, len(regs))}
+	for i, reg := range regs {
+		snap.checks[reg.checker.Name()] = results[i]
+		if results[i].Status != StatusOK && !reg.optional {
+			snap.failed = true
+		}
+	}
+	return snap
+}
+
+func run(ctx context.Context, reg registration) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- reg.checker.Check(ctx)
+	}()
+
+	// 检查项未响应ctx时 超时后直接返回 不阻塞整个报告
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	res := CheckResult{
+		Status:   StatusOK,
+		Optional: reg.optional,
+		Latency:  float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+	return res
+}
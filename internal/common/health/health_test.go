@@ -0,0 +1,82 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func reset(t *testing.T) {
+	t.Helper()
+	cleanup := func() {
+		mu.Lock()
+		checkers = nil
+		mu.Unlock()
+		cacheMu.Lock()
+		cached = nil
+		cacheMu.Unlock()
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+}
+
+func TestReadyCachesResults(t *testing.T) {
+	reset(t)
+	var calls atomic.Int32
+	Register(NewChecker("db", func(context.Context) error {
+		calls.Add(1)
+		return nil
+	}))
+
+	for range 3 {
+		if r := Ready(context.Background()); r.Status != StatusOK {
+			t.Fatalf("status=%s 期望%s", r.Status, StatusOK)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("缓存期间检查执行了%d次 期望1次", n)
+	}
+}
+
+func TestReadinessHidesErrors(t *testing.T) {
+	reset(t)
+	Register(NewChecker("smtp", func(context.Context) error {
+		return errors.New("dial tcp smtp.internal:25: connection refused")
+	}))
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	RegisterRoutes(engine)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("code=%d 期望503", w.Code)
+	}
+
+	if strings.Contains(w.Body.String(), "smtp.internal") {
+		t.Fatalf("公开响应不应包含错误信息: %s", w.Body.String())
+	}
+
+	var body struct {
+		Status string                    `json:"status"`
+		Checks map[string]map[string]any `json:"checks"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	smtp, ok := body.Checks["smtp"]
+	if body.Status != StatusFail || !ok {
+		t.Fatalf("公开响应应包含整体状态与各检查项: %s", w.Body.String())
+	}
+	if _, ok := smtp["latency_ms"]; !ok || smtp["status"] != StatusFail || smtp["error"] != nil {
+		t.Fatalf("检查项应只包含状态与耗时: %v", smtp)
+	}
+}
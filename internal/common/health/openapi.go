@@ -0,0 +1,21 @@
+package health
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// 接口文档 路径相对于挂载的/admin分组
+func init() {
+	openapi.Register(openapi.Operation{
+		Method:   http.MethodGet,
+		Path:     "/admin/health",
+		Summary:  "就绪检查详情",
+		Tags:     []string{"admin"},
+		Auth:     true,
+		Response: Report{},
+		Errors:   []codes.ErrCode{codes.ErrAdminRequired},
+	})
+}
@@ -37,8 +37,18 @@ func GenToken[T any](payload T, secret string, duration time.Duration) (string,
 }
 
 func ParseToken[T any](tokenString string, secret string) (myClaims MyClaims[T], err error) {
+	return ParseTokenWithKeys[T](tokenString, []string{secret})
+}
+
+// ParseTokenWithKeys 依次使用多个密钥校验签名 用于密钥轮换期间兼容旧令牌
+func ParseTokenWithKeys[T any](tokenString string, secrets []string) (myClaims MyClaims[T], err error) {
+	keySet := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(secrets))}
+	for _, secret := range secrets {
+		keySet.Keys = append(keySet.Keys, []byte(secret))
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, &MyClaims[T]{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
+		return keySet, nil
 	})
 
 	if err != nil {
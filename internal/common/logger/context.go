@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type ctxKey struct{}
+
+// With 返回携带附加字段的ctx 之后通过FromContext获取的logger都会带上这些字段
+func With(ctx context.Context, fields ...zap.Field) context.Context {
+	return context.WithValue(ctx, ctxKey{}, fromContext(ctx).With(fields...))
+}
+
+// FromContext 获取请求范围的logger 包含request_id、user_id、team_id与trace_id
+// ctx中没有logger时返回全局logger
+func FromContext(ctx context.Context) *zap.Logger {
+	l := fromContext(ctx)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		l = l.With(
+			zap.String("trace_id", sc.TraceID().String()),
+			zap.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return l
+}
+
+func fromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return zap.L()
+}
@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// 全局日志级别 支持运行时修改
+var level = zap.NewAtomicLevel()
+
+// 按logger名称覆盖的级别 写时复制 读取无锁
+var (
+	namedMu     sync.Mutex
+	namedLevels atomic.Pointer[map[string]zapcore.Level]
+)
+
+func init() {
+	namedLevels.Store(&map[string]zapcore.Level{})
+}
+
+// SetLevel 修改全局日志级别 立即对所有logger生效
+func SetLevel(l string) error {
+	if err := level.UnmarshalText([]byte(l)); err != nil {
+		return errors.New("无效的日志级别: " + l)
+	}
+	return nil
+}
+
+// SetNamedLevel 修改指定名称logger的级别 对其子logger同样生效 如 webhook 作用于 webhook.sender
+// name为空时修改全局级别
+func SetNamedLevel(name, l string) error {
+	if name == "" {
+		return SetLevel(l)
+	}
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(l)); err != nil {
+		return errors.New("无效的日志级别: " + l)
+	}
+	updateNamed(func(m map[string]zapcore.Level) { m[name] = lvl })
+	return nil
+}
+
+// ResetNamedLevel 取消指定名称logger的级别覆盖 恢复继承全局级别
+func ResetNamedLevel(name string) {
+	updateNamed(func(m map[string]zapcore.Level) { delete(m, name) })
+}
+
+// Levels 当前生效的级别 空字符串键为全局级别
+func Levels() map[string]string {
+	named := *namedLevels.Load()
+	out := make(map[string]string, len(named)+1)
+	out[""] = level.Level().String()
+	for name, l := range named {
+		out[name] = l.String()
+	}
+	return out
+}
+
+func updateNamed(fn func(m map[string]zapcore.Level)) {
+	namedMu.Lock()
+	defer namedMu.Unlock()
+
+	old := *namedLevels.Load()
+	next := make(map[string]zapcore.Level, len(old)+1)
+	for k, v := range old {
+		next[k] = v
+	}
+	fn(next)
+	namedLevels.Store(&next)
+}
+
+// levelFor 按名称逐级向上查找覆盖的级别 均未覆盖时使用全局级别
+func levelFor(name string) zapcore.Level {
+	named := *namedLevels.Load()
+	for name != "" {
+		if l, ok := named[name]; ok {
+			return l
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return level.Level()
+}
+
+// minLevel 所有级别中最低的 用于快速判断是否可能输出
+func minLevel() zapcore.Level {
+	lvl := level.Level()
+	for _, l := range *namedLevels.Load() {
+		if l < lvl {
+			lvl = l
+		}
+	}
+	return lvl
+}
+
+// levelCore 根据日志所属logger的名称判断级别
+type levelCore struct {
+	zapcore.Core
+}
+
+func (c *levelCore) Enabled(l zapcore.Level) bool {
+	return l >= minLevel()
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields)}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if entry.Level < levelFor(entry.LoggerName) {
+		return ce
+	}
+	return c.Core.Check(entry, ce)
+}
@@ -0,0 +1,65 @@
+package logadmin
+
+import (
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/reskit/response"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RegisterRoutes 注册运行时日志级别管理接口 调用方负责鉴权
+//
+//	GET    /log/levels          当前级别 空字符串键为全局级别
+//	PUT    /log/levels          修改级别 logger为空时修改全局级别
+//	DELETE /log/levels/:logger  取消指定logger的级别覆盖
+func RegisterRoutes(r *gin.RouterGroup) {
+	g := r.Group("/log/levels")
+	{
+		g.GET("", listLevels)
+		g.PUT("", setLevel)
+		g.DELETE("/:logger", resetLevel)
+	}
+}
+
+// SetLevelRequest logger为空时修改全局级别
+type SetLevelRequest struct {
+	Logger string `json:"logger"`
+	Level  string `json:"level" binding:"required"`
+}
+
+// LevelsResponse 当前生效的日志级别
+type LevelsResponse struct {
+	// 空字符串键为全局级别
+	Levels map[string]string `json:"levels"`
+}
+
+func listLevels(ctx *gin.Context) {
+	response.Success(ctx, LevelsResponse{Levels: logger.Levels()})
+}
+
+func setLevel(ctx *gin.Context) {
+	req := new(SetLevelRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	if err := logger.SetNamedLevel(req.Logger, req.Level); err != nil {
+		response.Error(ctx, codes.ErrLogLevelInvalid.WithDetail(map[string]any{"level": req.Level}))
+		return
+	}
+	logger.FromContext(ctx.Request.Context()).Info("日志级别已修改",
+		zap.String("logger", req.Logger), zap.String("level", req.Level))
+
+	response.Success(ctx, LevelsResponse{Levels: logger.Levels()})
+}
+
+func resetLevel(ctx *gin.Context) {
+	name := ctx.Param("logger")
+	logger.ResetNamedLevel(name)
+	logger.FromContext(ctx.Request.Context()).Info("日志级别覆盖已取消", zap.String("logger", name))
+
+	response.Success(ctx, LevelsResponse{Levels: logger.Levels()})
+}
@@ -0,0 +1,42 @@
+package logadmin
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// 接口文档 路径相对于挂载的/admin分组
+func init() {
+	openapi.Register(
+		openapi.Operation{
+			Method:   http.MethodGet,
+			Path:     "/admin/log/levels",
+			Summary:  "当前日志级别",
+			Tags:     []string{"admin"},
+			Auth:     true,
+			Response: LevelsResponse{},
+			Errors:   []codes.ErrCode{codes.ErrAdminRequired},
+		},
+		openapi.Operation{
+			Method:   http.MethodPut,
+			Path:     "/admin/log/levels",
+			Summary:  "修改日志级别",
+			Tags:     []string{"admin"},
+			Auth:     true,
+			Request:  SetLevelRequest{},
+			Response: LevelsResponse{},
+			Errors:   []codes.ErrCode{codes.ErrAdminRequired, codes.ErrLogLevelInvalid},
+		},
+		openapi.Operation{
+			Method:   http.MethodDelete,
+			Path:     "/admin/log/levels/:logger",
+			Summary:  "取消日志级别覆盖",
+			Tags:     []string{"admin"},
+			Auth:     true,
+			Response: LevelsResponse{},
+			Errors:   []codes.ErrCode{codes.ErrAdminRequired},
+		},
+	)
+}
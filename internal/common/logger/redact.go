@@ -0,0 +1,227 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const redacted = "[REDACTED]"
+
+// 字段名包含以下片段时整体脱敏 不区分大小写
+var defaultSensitiveKeys = []string{
+	"password", "passwd", "secret", "token", "authorization", "cookie", "api_key", "apikey",
+}
+
+// 字段名完全匹配且值为字符串时脱敏 code过于通用 数字形式的错误码不受影响
+var defaultSensitiveExactKeys = []string{"code", "oauth_code"}
+
+var (
+	bearerPattern = regexp.MustCompile(`(?i)(bearer|basic)\s+[A-Za-z0-9\-._~+/]+=*`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// 40位以上的十六进制串视为令牌 trace_id为32位不受影响
+	hexTokenPattern = regexp.MustCompile(`\b[a-fA-F0-9]{40,}\b`)
+	emailPattern    = regexp.MustCompile(`([A-Za-z0-9._%+-])[A-Za-z0-9._%+-]*@([A-Za-z0-9.-]+\.[A-Za-z]{2,})`)
+)
+
+// Redactor 按字段名与内容模式脱敏
+type Redactor struct {
+	enabled   bool
+	emails    bool
+	keys      []string
+	exactKeys map[string]struct{}
+}
+
+// NewRedactor extraKeys为额外的敏感字段名片段
+func NewRedactor(enabled, emails bool, extraKeys []string) *Redactor {
+	r := &Redactor{
+		enabled:   enabled,
+		emails:    emails,
+		exactKeys: make(map[string]struct{}, len(defaultSensitiveExactKeys)),
+	}
+	for _, k := range append(append([]string{}, defaultSensitiveKeys...), extraKeys...) {
+		r.keys = append(r.keys, strings.ToLower(k))
+	}
+	for _, k := range defaultSensitiveExactKeys {
+		r.exactKeys[k] = struct{}{}
+	}
+	return r
+}
+
+// 默认开启 Init之前的日志同样脱敏
+var redactor = NewRedactor(true, true, nil)
+
+// Redact 使用全局配置对字符串脱敏 用于不经过zap的输出
+func Redact(s string) string {
+	return redactor.String(s)
+}
+
+// RedactValue 使用全局配置对map等结构脱敏 返回脱敏后的副本
+func RedactValue(v any) any {
+	return redactor.Value(v)
+}
+
+// IsSensitiveKey 字段名是否需要整体脱敏 isString为值是否为字符串
+func (r *Redactor) IsSensitiveKey(key string, isString bool) bool {
+	if !r.enabled {
+		return false
+	}
+	k := strings.ToLower(key)
+	if _, ok := r.exactKeys[k]; ok && isString {
+		return true
+	}
+	for _, s := range r.keys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// String 替换字符串中的令牌与邮箱
+func (r *Redactor) String(s string) string {
+	if !r.enabled || s == "" {
+		return s
+	}
+	s = bearerPattern.ReplaceAllString(s, "$1 "+redacted)
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	s = hexTokenPattern.ReplaceAllString(s, redacted)
+	if r.emails {
+		// 保留首字母与域名 便于排查
+		s = emailPattern.ReplaceAllString(s, "$1***@$2")
+	}
+	return s
+}
+
+// Value 递归处理map、slice与结构体 结构体先转为JSON对象再处理
+func (r *Redactor) Value(v any) any {
+	if !r.enabled || v == nil {
+		return v
+	}
+	switch val := v.(type) {
+	case string:
+		return r.String(val)
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			if _, isString := item.(string); r.IsSensitiveKey(k, isString) {
+				out[k] = redacted
+				continue
+			}
+			out[k] = r.Value(item)
+		}
+		return out
+	case map[string]string:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			if r.IsSensitiveKey(k, true) {
+				out[k] = redacted
+				continue
+			}
+			out[k] = r.String(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = r.Value(item)
+		}
+		return out
+	case bool, int, int32, int64, uint, uint32, uint64, float32, float64, json.Number:
+		return val
+	case error:
+		return r.String(val.Error())
+	case fmt.Stringer:
+		return r.String(val.String())
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return v
+	}
+	return r.Value(generic)
+}
+
+// Fields 处理zap字段
+func (r *Redactor) Fields(fields []zapcore.Field) []zapcore.Field {
+	if !r.enabled || len(fields) == 0 {
+		return fields
+	}
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		out[i] = r.field(f)
+	}
+	return out
+}
+
+func (r *Redactor) field(f zapcore.Field) zapcore.Field {
+	if r.IsSensitiveKey(f.Key, f.Type == zapcore.StringType) {
+		return zap.String(f.Key, redacted)
+	}
+	switch f.Type {
+	case zapcore.StringType:
+		f.String = r.String(f.String)
+	case zapcore.ByteStringType:
+		return zap.ByteString(f.Key, []byte(r.String(string(f.Interface.([]byte)))))
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return zap.NamedError(f.Key, &redactedError{err: err, r: r})
+		}
+	case zapcore.StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok {
+			return zap.String(f.Key, r.String(s.String()))
+		}
+	case zapcore.ReflectType:
+		return zap.Any(f.Key, r.Value(f.Interface))
+	}
+	return f
+}
+
+// redactedError 保留错误栈输出 同时脱敏错误信息
+type redactedError struct {
+	err error
+	r   *Redactor
+}
+
+func (e *redactedError) Error() string {
+	return e.r.String(e.err.Error())
+}
+
+func (e *redactedError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprint(s, e.r.String(fmt.Sprintf("%+v", e.err)))
+		return
+	}
+	_, _ = fmt.Fprint(s, e.Error())
+}
+
+// redactCore 在写入前对日志消息与字段脱敏
+type redactCore struct {
+	zapcore.Core
+	r *Redactor
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.r.Fields(fields)), r: c.r}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.r.String(entry.Message)
+	entry.Stack = c.r.String(entry.Stack)
+	return c.Core.Write(entry, c.r.Fields(fields))
+}
@@ -2,9 +2,8 @@ package logger
 
 import (
 	"errors"
-	"github.com/joho/godotenv"
 	"os"
-	"strconv"
+	"sass-scaffold/internal/common/config"
 	"time"
 
 	"github.com/natefinch/lumberjack"
@@ -12,65 +11,48 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-type zapConfig struct {
-	level      string
-	fileName   string
-	maxSize    int
-	maxAge     int
-	maxBackups int
-}
-
-var config zapConfig
+var conf config.LogConfig
 
-func UpdateConfig() {
-	level := os.Getenv("LOG_LEVEL")
-	fileName := os.Getenv("LOG_FILENAME")
-	maxSizeStr := os.Getenv("LOG_MAX_SIZE")
-	maxSize, err := strconv.Atoi(maxSizeStr)
-	if err != nil {
-		panic(err)
-	}
-	maxAgeStr := os.Getenv("LOG_MAX_AGE")
-	maxAge, err := strconv.Atoi(maxAgeStr)
-	if err != nil {
-		panic(err)
-	}
-	maxBackupsStr := os.Getenv("LOG_MAX_BACKUPS")
-	maxBackups, err := strconv.Atoi(maxBackupsStr)
-	if err != nil {
-		panic(err)
-	}
+// 日志编码格式
+const (
+	EncoderJSON    = "json"
+	EncoderConsole = "console"
+)
 
-	if level == "" || fileName == "" || maxSize == 0 || maxAge == 0 || maxBackups == 0 {
-		panic(errors.New("zap配置文件加载失败"))
-	}
+func Init(cfg config.LogConfig) (err error) {
+	conf = cfg
 
-	config = zapConfig{
-		level:      level,
-		fileName:   fileName,
-		maxSize:    maxSize,
-		maxAge:     maxAge,
-		maxBackups: maxBackups,
+	if err = SetLevel(conf.Level); err != nil {
+		return err
 	}
 
-}
+	// 各输出只负责编码与写入 级别由levelCore按logger名称判断
+	all := zap.LevelEnablerFunc(func(zapcore.Level) bool { return true })
 
-func Init() (err error) {
-	_ = godotenv.Load()
-
-	UpdateConfig()
-	writeSyncer := getLogWriter()
-	// 创建编码器
-	encoder := getEncoder()
-
-	var l = new(zapcore.Level)
-	err = l.UnmarshalText([]byte(config.level))
+	stdoutEncoder, err := getEncoder(conf.StdoutEncoder)
 	if err != nil {
-		return errors.New("l.UnmarshalText([]byte(cfg.Level)) failed")
+		return err
+	}
+	cores := []zapcore.Core{zapcore.NewCore(stdoutEncoder, zapcore.AddSync(os.Stdout), all)}
+
+	// 容器部署时日志由stdout采集 可关闭文件输出
+	if conf.FileEnabled {
+		fileEncoder, err := getEncoder(conf.FileEncoder)
+		if err != nil {
+			return err
+		}
+		cores = append(cores, zapcore.NewCore(fileEncoder, getLogWriter(), all))
 	}
 
+	redactor = NewRedactor(conf.Redact, conf.RedactEmails, conf.RedactKeys)
+
 	var core zapcore.Core
-	core = zapcore.NewCore(encoder, writeSyncer, l)
+	core = &redactCore{Core: zapcore.NewTee(cores...), r: redactor}
+	// 同一秒内相同消息超过SamplingInitial条后 每SamplingThereafter条只记录一条
+	if conf.SamplingInitial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, time.Second, conf.SamplingInitial, conf.SamplingThereafter)
+	}
+	core = &levelCore{Core: core}
 
 	lg := zap.New(core, zap.AddCaller())
 	// 替换zap包中全局的logger实例，后续在其他包中只需使用zap.L()调用即可
@@ -80,28 +62,31 @@ func Init() (err error) {
 
 func getLogWriter() zapcore.WriteSyncer {
 	lumberJackLogger := &lumberjack.Logger{
-		Filename:   config.fileName,
-		MaxSize:    config.maxSize,
-		MaxBackups: config.maxBackups,
-		MaxAge:     config.maxAge,
+		Filename:   conf.FileName,
+		MaxSize:    conf.MaxSize,
+		MaxBackups: conf.MaxBackups,
+		MaxAge:     conf.MaxAge,
 	}
-
-	// 添加文件写入器
-	writers := []zapcore.WriteSyncer{zapcore.AddSync(lumberJackLogger)}
-
-	writers = append(writers, zapcore.AddSync(os.Stdout))
-
-	return zapcore.NewMultiWriteSyncer(writers...)
+	return zapcore.AddSync(lumberJackLogger)
 }
 
-func getEncoder() zapcore.Encoder {
+func getEncoder(format string) (zapcore.Encoder, error) {
 	encoderConfig := zap.NewProductionEncoderConfig()
 	encoderConfig.EncodeTime = customTimeEncoder
 	encoderConfig.TimeKey = "time"
 	encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
 	encoderConfig.EncodeDuration = zapcore.SecondsDurationEncoder
 	encoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
-	return zapcore.NewJSONEncoder(encoderConfig)
+
+	switch format {
+	case EncoderJSON:
+		return zapcore.NewJSONEncoder(encoderConfig), nil
+	case EncoderConsole:
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		return zapcore.NewConsoleEncoder(encoderConfig), nil
+	default:
+		return nil, errors.New("不支持的日志编码格式: " + format)
+	}
 }
 
 func customTimeEncoder(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
@@ -0,0 +1,27 @@
+﻿package metrics
+
+import (
+	"context"
+
+	"sass-scaffold/internal/common/eventbus"
+)
+
+// SubscribeBusiness 订阅业务事件统计登录、注册与令牌刷新次数
+func SubscribeBusiness(bus *eventbus.Bus, client Client) {
+	logins := client.Counter("user_logins_total", "Total number of user logins.", "provider")
+	signups := client.Counter("user_signups_total", "Total number of user signups.", "provider")
+	refreshes := client.Counter("user_token_refreshes_total", "Total number of access token refreshes.")
+
+	eventbus.On(bus, func(ctx context.Context, e eventbus.UserLoggedIn) error {
+		logins.Inc(e.Provider)
+		return nil
+	})
+	eventbus.On(bus, func(ctx context.Context, e eventbus.UserRegistered) error {
+		signups.Inc(e.Provider)
+		return nil
+	})
+	eventbus.On(bus, func(ctx context.Context, e eventbus.TokenRefreshed) error {
+		refreshes.Inc()
+		return nil
+	})
+}
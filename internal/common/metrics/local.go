@@ -1,11 +1,32 @@
 ﻿package metrics
 
+// NoOp 未启用指标时使用 丢弃所有数据
 type NoOp struct {
 }
 
-func (n NoOp) Inc(action, status string, value int) {
+func (n NoOp) Counter(name, help string, labels ...string) Counter {
+	return noOpInstrument{}
+}
+
+func (n NoOp) Gauge(name, help string, labels ...string) Gauge {
+	return noOpInstrument{}
+}
+
+func (n NoOp) Histogram(name, help string, buckets []float64, labels ...string) Histogram {
+	return noOpInstrument{}
+}
+
+type noOpInstrument struct {
+}
+
+func (noOpInstrument) Inc(labelValues ...string) {
+}
+
+func (noOpInstrument) Add(value float64, labelValues ...string) {
+}
 
+func (noOpInstrument) Set(value float64, labelValues ...string) {
 }
 
-func (n NoOp) ObserveDuration(action, status string, seconds float64) {
+func (noOpInstrument) Observe(value float64, labelValues ...string) {
 }
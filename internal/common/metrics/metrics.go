@@ -1,6 +1,77 @@
 ﻿package metrics
 
+import (
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/config"
+)
+
+// Client 指标客户端 同名指标重复获取时返回同一个实例
 type Client interface {
-	Inc(action, status string, value int)
-	ObserveDuration(action, status string, seconds float64)
+	Counter(name, help string, labels ...string) Counter
+	Gauge(name, help string, labels ...string) Gauge
+	Histogram(name, help string, buckets []float64, labels ...string) Histogram
+}
+
+// Counter 只增计数器 labelValues需与创建时的labels一一对应
+type Counter interface {
+	Inc(labelValues ...string)
+	Add(value float64, labelValues ...string)
+}
+
+// Gauge 可增可减的瞬时值
+type Gauge interface {
+	Set(value float64, labelValues ...string)
+	Add(value float64, labelValues ...string)
+}
+
+// Histogram 分布统计 如耗时
+type Histogram interface {
+	Observe(value float64, labelValues ...string)
+}
+
+// DefaultBuckets 耗时类指标的默认分桶 单位秒
+var DefaultBuckets = []float64{0.01, 0.05, 0.1, 0.2, 0.5, 1, 2, 5}
+
+var instance Client = NoOp{}
+
+// Init 按配置创建全局指标客户端 未启用时使用NoOp
+func Init(cfg config.PrometheusConfig) error {
+	if !cfg.Enabled {
+		instance = NoOp{}
+		return nil
+	}
+
+	client := NewPrometheusClient()
+	if err := startServer(cfg, client.Handler()); err != nil {
+		return err
+	}
+	instance = client
+	return nil
+}
+
+// GetClientInstance 获取全局指标客户端
+func GetClientInstance() Client {
+	return instance
+}
+
+// startServer 在独立端口暴露指标 避免与业务接口共用鉴权与CORS
+func startServer(cfg config.PrometheusConfig, handler http.Handler) error {
+	ln, err := net.Listen("tcp", ":"+cfg.Port)
+	if err != nil {
+		return errors.WithMessage(err, "prometheus端口监听失败")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(cfg.Path, handler)
+	go func() {
+		if err := http.Serve(ln, mux); err != nil {
+			zap.L().Error("prometheus服务退出", zap.Error(err))
+		}
+	}()
+	return nil
 }
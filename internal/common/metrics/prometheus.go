@@ -1,62 +1,145 @@
 ﻿package metrics
 
 import (
-	"github.com/joho/godotenv"
-	"github.com/pkg/errors"
+	"fmt"
+	"net/http"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"net/http"
-	"os"
+	"go.uber.org/zap"
 )
 
 type PrometheusClient struct {
-	counter   *prometheus.CounterVec
-	histogram *prometheus.HistogramVec
+	registry *prometheus.Registry
+
+	mu      sync.Mutex
+	metrics map[string]prometheus.Collector
 }
 
+// NewPrometheusClient 创建独立registry 并注册Go运行时与进程指标
 func NewPrometheusClient() *PrometheusClient {
-	c := prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "http_requests_total",
-			Help: "Total number of HTTP requests",
-		},
-		[]string{"action", "status"},
-	)
-	h := prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name:    "http_request_duration_seconds",
-			Help:    "HTTP request duration in seconds",
-			Buckets: []float64{0.01, 0.05, 0.1, 0.2, 0.5, 1, 2, 5},
-		},
-		[]string{"action", "status"},
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
-	prometheus.MustRegister(c, h)
-	return &PrometheusClient{counter: c, histogram: h}
+	return &PrometheusClient{
+		registry: registry,
+		metrics:  make(map[string]prometheus.Collector),
+	}
 }
 
-func (p *PrometheusClient) Inc(action, status string, value int) {
-	p.counter.WithLabelValues(action, status).Add(float64(value))
+// RegisterCollector 向全局客户端注册自定义采集器 未启用指标时忽略
+func RegisterCollector(c prometheus.Collector) error {
+	if p, ok := instance.(*PrometheusClient); ok {
+		return p.Register(c)
+	}
+	return nil
 }
 
-func (p *PrometheusClient) ObserveDuration(action, status string, seconds float64) {
-	p.histogram.WithLabelValues(action, status).Observe(seconds)
+// Handler 指标导出接口
+func (p *PrometheusClient) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{Registry: p.registry})
 }
 
-var (
-	path string
-	port string
-)
+// Register 注册自定义采集器 如连接池状态
+func (p *PrometheusClient) Register(c prometheus.Collector) error {
+	return p.registry.Register(c)
+}
+
+func (p *PrometheusClient) Counter(name, help string, labels ...string) Counter {
+	vec := getOrRegister(p, name, func() *prometheus.CounterVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	})
+	return promCounter{vec}
+}
+
+func (p *PrometheusClient) Gauge(name, help string, labels ...string) Gauge {
+	vec := getOrRegister(p, name, func() *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)
+	})
+	return promGauge{vec}
+}
+
+func (p *PrometheusClient) Histogram(name, help string, buckets []float64, labels ...string) Histogram {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	vec := getOrRegister(p, name, func() *prometheus.HistogramVec {
+		return prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)
+	})
+	return promHistogram{vec}
+}
+
+// getOrRegister 同名指标只注册一次 类型不一致属于编码错误 直接panic
+func getOrRegister[T prometheus.Collector](p *PrometheusClient, name string, create func() T) T {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c, ok := p.metrics[name]; ok {
+		vec, ok := c.(T)
+		if !ok {
+			panic(fmt.Sprintf("指标%s已注册为其他类型", name))
+		}
+		return vec
+	}
+
+	vec := create()
+	p.registry.MustRegister(vec)
+	p.metrics[name] = vec
+	return vec
+}
+
+type promCounter struct {
+	vec *prometheus.CounterVec
+}
+
+func (c promCounter) Inc(labelValues ...string) {
+	if m, ok := withLabels(c.vec.GetMetricWithLabelValues, labelValues); ok {
+		m.Inc()
+	}
+}
+
+func (c promCounter) Add(value float64, labelValues ...string) {
+	if m, ok := withLabels(c.vec.GetMetricWithLabelValues, labelValues); ok {
+		m.Add(value)
+	}
+}
+
+type promGauge struct {
+	vec *prometheus.GaugeVec
+}
+
+func (g promGauge) Set(value float64, labelValues ...string) {
+	if m, ok := withLabels(g.vec.GetMetricWithLabelValues, labelValues); ok {
+		m.Set(value)
+	}
+}
+
+func (g promGauge) Add(value float64, labelValues ...string) {
+	if m, ok := withLabels(g.vec.GetMetricWithLabelValues, labelValues); ok {
+		m.Add(value)
+	}
+}
 
-func init() {
-	_ = godotenv.Load()
-	path = os.Getenv("PROMETHEUS_PATH")
-	port = os.Getenv("PROMETHEUS_ADDR")
-	if path == "" || port == "" {
-		panic(errors.New("Prometheus读取环境变量失败"))
+type promHistogram struct {
+	vec *prometheus.HistogramVec
+}
+
+func (h promHistogram) Observe(value float64, labelValues ...string) {
+	if m, ok := withLabels(h.vec.GetMetricWithLabelValues, labelValues); ok {
+		m.Observe(value)
 	}
 }
 
-func StartPrometheusServer() {
-	http.Handle(path, promhttp.Handler())
-	go http.ListenAndServe(":"+port, nil)
+// withLabels label数量不匹配时只记录日志 不影响业务
+func withLabels[T any](get func(...string) (T, error), labelValues []string) (T, bool) {
+	m, err := get(labelValues...)
+	if err != nil {
+		zap.L().Warn("指标label不匹配", zap.Strings("labels", labelValues), zap.Error(err))
+		return m, false
+	}
+	return m, true
 }
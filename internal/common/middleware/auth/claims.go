@@ -0,0 +1,18 @@
+package auth
+
+import "time"
+
+// Claims access token中的业务声明 由用户模块签发 认证中间件与令牌内省共用
+type Claims struct {
+	UserID     string `json:"user_id"`
+	RandomCode string `json:"random_code"`
+	// 令牌限定的权限范围 Restricted为true时只能访问其中的scope
+	Scopes []string `json:"scopes,omitempty"`
+	// 受scope限制 未携带该声明的令牌不受限制 包括用户登录签发及升级前签发的令牌
+	Restricted bool `json:"restricted,omitempty"`
+
+	// 以下取自标准声明 由Verifier校验后填充 不写入payload
+	Issuer    string    `json:"-"`
+	IssuedAt  time.Time `json:"-"`
+	ExpiresAt time.Time `json:"-"`
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// User 已认证的调用方
+type User struct {
+	ID string
+	// 令牌限定的权限范围
+	Scopes []string
+	// 受scope限制 为true时只能访问Scopes中的scope
+	Restricted bool
+}
+
+// HasScope 令牌是否允许访问该scope 只有标记为受限的令牌需要检查Scopes
+func (u *User) HasScope(scope string) bool {
+	return !u.Restricted || slices.Contains(u.Scopes, scope)
+}
+
+type userKey struct{}
+
+// WithUser 将认证后的用户存入ctx
+func WithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext 获取认证后的用户 gin中需传入c.Request.Context()
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userKey{}).(*User)
+	return user, ok && user != nil
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// gRPC metadata的键统一为小写
+const grpcAuthKey = "authorization"
+
+// UnaryServerInterceptor 校验metadata中的Bearer令牌 publicMethods为无需认证的完整方法名
+func (m *Middleware) UnaryServerInterceptor(publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := toSet(publicMethods)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		ctx, err := m.authenticateGrpc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor 流式接口的令牌校验 规则与UnaryServerInterceptor相同
+func (m *Middleware) StreamServerInterceptor(publicMethods ...string) grpc.StreamServerInterceptor {
+	public := toSet(publicMethods)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		ctx, err := m.authenticateGrpc(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (m *Middleware) authenticateGrpc(ctx context.Context) (context.Context, error) {
+	tokenStr, err := parseTokenFromMetadata(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	return m.authenticate(ctx, tokenStr)
+}
+
+func parseTokenFromMetadata(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(grpcAuthKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", codes.ErrUnauthorized.WithSlug("token为空")
+	}
+
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", codes.ErrUnauthorized.WithSlug("token格式错误")
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix), nil
+}
+
+func toSet(items []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// 替换流的ctx 使处理函数能取到用户ID
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
@@ -1,45 +1,65 @@
 package auth
 
 import (
-	"github.com/pkg/errors"
+	"context"
+	"github.com/google/wire"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/logger"
 	"sass-scaffold/internal/common/reskit/codes"
 	"sass-scaffold/internal/common/reskit/response"
-	"sass-scaffold/internal/user/adapters"
-	"sass-scaffold/internal/user/domain"
-	"sass-scaffold/internal/user/service"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
-var tokenServer domain.TokenService
+// Verifier 校验access token 由用户模块的TokenService实现
+type Verifier interface {
+	ValidateAccessToken(ctx context.Context, token string) (claims Claims, isExpire bool, err error)
+}
+
+// Middleware 认证中间件 Verifier由wire注入 本包不创建数据库与Redis连接
+type Middleware struct {
+	verifier	Verifier
+}
 
-func init() {
-	tokenCache := adapters.NewRedisTokenCache()
-	userRepo := adapters.NewPSQLUserRepository()
-	tokenServer = service.NewTokenService(tokenCache, userRepo)
+func NewMiddleware(verifier Verifier) *Middleware {
+	return &Middleware{
+		verifier:	verifier,
+	}
 }
 
+// VerifierOf 中间件使用的Verifier 供令牌内省等需要直接校验令牌的模块复用
+func VerifierOf(m *Middleware) Verifier {
+	return m.verifier
+}
+
+// ProviderSet wire注入 Verifier由使用方绑定 如用户模块的TokenService
+var ProviderSet = wire.NewSet(
+	NewMiddleware,
+)
+
 const (
 	authHeaderKey	= "Authorization"
 	bearerPrefix	= "Bearer "
 )
 
-// 解析 Authorization 头部的 Token
+// 解析 Authorization 头部的 Token 与gRPC一致返回未授权
 func parseTokenFromHeader(c *gin.Context) (string, error) {
 	authHeader := c.GetHeader(authHeaderKey)
 	if authHeader == "" {
-		return "", errors.New("token为空")
+		return "", codes.ErrUnauthorized.WithSlug("token为空")
 	}
 
 	if !strings.HasPrefix(authHeader, bearerPrefix) {
-		return "", errors.New("token格式错误")
+		return "", codes.ErrUnauthorized.WithSlug("token格式错误")
 	}
 
 	return strings.TrimPrefix(authHeader, bearerPrefix), nil
 }
 
-func Validate() gin.HandlerFunc {
+// Validate 必须携带有效的access token
+func (m *Middleware) Validate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 从请求头解析 Token
 		tokenStr, err := parseTokenFromHeader(c)
@@ -48,21 +68,95 @@ func Validate() gin.HandlerFunc {
 			return
 		}
 
-		// 2. 解析 Token
-		payload, isExpire, err := tokenServer.ValidateAccessToken(tokenStr)
+		// 2. 解析 Token 并将用户信息存入请求ctx
+		if !m.authenticateGin(c, tokenStr) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Optional 未携带令牌时以匿名身份继续 携带了令牌但无效时仍返回错误
+func (m *Middleware) Optional() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(authHeaderKey) == "" {
+			c.Next()
+			return
+		}
+
+		tokenStr, err := parseTokenFromHeader(c)
 		if err != nil {
-			if isExpire {
-				response.Error(c, codes.ErrTokenExpired)
-			} else {
-				response.Error(c, codes.ErrTokenInvalid)
-			}
+			response.Error(c, err)
+			return
+		}
+
+		if !m.authenticateGin(c, tokenStr) {
 			return
 		}
 
-		// 3. 将用户 相关信息存入上下文
-		c.Set("user_id", payload.UserID)
-		//c.Set("random_code", payload.RandomCode)
+		c.Next()
+	}
+}
+
+// RequireScope 令牌需包含全部scope 需在Validate之后使用 不受限的令牌直接通过
+func (m *Middleware) RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := UserFromContext(c.Request.Context())
+		if !ok {
+			response.Error(c, codes.ErrUnauthorized)
+			return
+		}
+
+		for _, scope := range scopes {
+			if !user.HasScope(scope) {
+				response.Error(c, codes.ErrInsufficientScope.WithDetail(map[string]any{"scope": scope}))
+				return
+			}
+		}
 
 		c.Next()
 	}
 }
+
+// 校验失败时已写入错误响应
+func (m *Middleware) authenticateGin(c *gin.Context, tokenStr string) bool {
+	ctx, err := m.authenticate(c.Request.Context(), tokenStr)
+	if err != nil {
+		response.Error(c, err)
+		return false
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+	return true
+}
+
+// 返回携带用户信息的ctx HTTP与gRPC共用
+func (m *Middleware) authenticate(ctx context.Context, tokenStr string) (context.Context, error) {
+	payload, isExpire, err := m.verifier.ValidateAccessToken(ctx, tokenStr)
+	if err != nil {
+		if isExpire {
+			return ctx, codes.ErrTokenExpired
+		}
+		return ctx, codes.ErrTokenInvalid
+	}
+
+	ctx = WithUser(ctx, &User{ID: payload.UserID, Scopes: payload.Scopes, Restricted: payload.Restricted})
+	return logger.With(ctx, zap.String("user_id", payload.UserID)), nil
+}
+
+// RequireAdmin 仅允许SERVER_ADMIN_USER_IDS中的用户访问 需在Validate之后使用
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := UserFromContext(c.Request.Context())
+		if ok {
+			for _, id := range config.GetConfigInstance().Server.AdminUserIDs {
+				if id == user.ID {
+					c.Next()
+					return
+				}
+			}
+		}
+		response.Error(c, codes.ErrAdminRequired)
+	}
+}
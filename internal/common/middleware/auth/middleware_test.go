@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// stubVerifier 按令牌字符串返回预设的声明
+type stubVerifier map[string]Claims
+
+func (v stubVerifier) ValidateAccessToken(_ context.Context, token string) (Claims, bool, error) {
+	claims, ok := v[token]
+	if !ok {
+		return Claims{}, false, errors.New("invalid token")
+	}
+	return claims, false, nil
+}
+
+func TestHasScope(t *testing.T) {
+	cases := []struct {
+		name string
+		user User
+		want bool
+	}{
+		{"未标记受限", User{ID: "u"}, true},
+		{"未标记受限且带scope", User{ID: "u", Scopes: []string{"audit:read"}}, true},
+		{"受限且包含scope", User{ID: "u", Scopes: []string{"webhooks"}, Restricted: true}, true},
+		{"受限且不包含scope", User{ID: "u", Scopes: []string{"audit:read"}, Restricted: true}, false},
+		{"受限且无scope", User{ID: "u", Restricted: true}, false},
+	}
+	for _, c := range cases {
+		if got := c.user.HasScope("webhooks"); got != c.want {
+			t.Errorf("%s: HasScope=%v 期望%v", c.name, got, c.want)
+		}
+	}
+}
+
+func newEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	m := NewMiddleware(stubVerifier{
+		"login":  {UserID: "u1"},
+		"scoped": {UserID: "u2", Scopes: []string{"audit:read"}, Restricted: true},
+		"empty":  {UserID: "u3", Restricted: true},
+	})
+
+	engine := gin.New()
+	engine.GET("/webhooks", m.Validate(), m.RequireScope("webhooks"), func(c *gin.Context) { c.Status(http.StatusOK) })
+	engine.GET("/optional", m.Optional(), func(c *gin.Context) {
+		if _, ok := UserFromContext(c.Request.Context()); ok {
+			c.Status(http.StatusOK)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+	return engine
+}
+
+func serve(engine *gin.Engine, path, token string) int {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set(authHeaderKey, bearerPrefix+token)
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestRequireScope(t *testing.T) {
+	engine := newEngine()
+	cases := map[string]int{
+		"login":  http.StatusOK,
+		"scoped": http.StatusForbidden,
+		"empty":  http.StatusForbidden,
+	}
+	for token, want := range cases {
+		if got := serve(engine, "/webhooks", token); got != want {
+			t.Errorf("令牌%s: 响应码=%d 期望%d", token, got, want)
+		}
+	}
+}
+
+func TestOptional(t *testing.T) {
+	engine := newEngine()
+	if got := serve(engine, "/optional", ""); got != http.StatusNoContent {
+		t.Errorf("未携带令牌: 响应码=%d 期望匿名访问", got)
+	}
+	if got := serve(engine, "/optional", "scoped"); got != http.StatusOK {
+		t.Errorf("有效令牌: 响应码=%d 期望200", got)
+	}
+	if got := serve(engine, "/optional", "bad"); got < http.StatusBadRequest {
+		t.Errorf("无效令牌: 响应码=%d 期望返回错误", got)
+	}
+}
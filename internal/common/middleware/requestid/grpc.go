@@ -0,0 +1,34 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"sass-scaffold/internal/common/logger"
+)
+
+// UnaryServerInterceptor 沿用metadata中的x-request-id 规则与HTTP的Middleware相同
+// 请求ID通过响应header返回
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	key := strings.ToLower(HeaderKey)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(key); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		if !valid(id) {
+			id = uuid.Must(uuid.NewV4()).String()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(key, id))
+
+		ctx = context.WithValue(ctx, ctxKey{}, id)
+		return handler(logger.With(ctx, zap.String("request_id", id)), req)
+	}
+}
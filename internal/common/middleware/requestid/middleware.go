@@ -0,0 +1,62 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/logger"
+)
+
+const (
+	HeaderKey = "X-Request-ID"
+
+	// 上游传入的请求ID超过该长度时重新生成 避免日志被超长值污染
+	maxLength = 128
+)
+
+type ctxKey struct{}
+
+// Middleware 沿用上游的X-Request-ID 没有或不合法时生成新的
+// 请求ID写入响应头、请求ctx以及请求范围的logger
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderKey)
+		if !valid(id) {
+			id = uuid.Must(uuid.NewV4()).String()
+		}
+		c.Header(HeaderKey, id)
+
+		fields := []zap.Field{zap.String("request_id", id)}
+		// 团队相关路由统一使用:id作为团队ID
+		if strings.HasPrefix(c.FullPath(), "/api/v1/teams/:id") {
+			fields = append(fields, zap.String("team_id", c.Param("id")))
+		}
+
+		ctx := context.WithValue(c.Request.Context(), ctxKey{}, id)
+		c.Request = c.Request.WithContext(logger.With(ctx, fields...))
+		c.Next()
+	}
+}
+
+// FromContext 获取当前请求的ID 不在请求范围内时返回空字符串
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// 只接受可打印的ASCII字符
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// swagger-ui 通过CDN加载 仅开发模式提供
+const swaggerUIHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>SaaS Scaffold API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+<script>
+  window.ui = SwaggerUIBundle({ url: "` + PathPrefix + `/openapi.json", dom_id: "#swagger-ui" });
+</script>
+</body>
+</html>`
+
+// RegisterRoutes 注册 /api/openapi.json 开发模式下同时注册 /api/docs
+// 需在所有业务路由注册之后调用 启动时记录路由与文档不一致之处
+func RegisterRoutes(engine *gin.Engine, dev bool) {
+	doc, drift := Build(engine.Routes())
+	for _, d := range drift {
+		zap.L().Warn("OpenAPI文档与路由不一致", zap.String("drift", d))
+	}
+
+	engine.GET(PathPrefix+"/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	})
+	if dev {
+		engine.GET(PathPrefix+"/docs", func(c *gin.Context) {
+			c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIHTML))
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema OpenAPI 3.0 Schema Object 只包含用到的字段
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaBuilder 结构体生成到components中 通过$ref引用
+type schemaBuilder struct {
+	components map[string]*Schema
+	// 类型 -> components中的名称 同名不同包的类型加包名前缀
+	names map[reflect.Type]string
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{components: map[string]*Schema{}, names: map[reflect.Type]string{}}
+}
+
+func (b *schemaBuilder) schemaOf(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	var s *Schema
+	switch {
+	case t == timeType:
+		s = &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		// 任意JSON
+		s = &Schema{}
+	case t.Kind() == reflect.Struct:
+		s = &Schema{Ref: "#/components/schemas/" + b.component(t)}
+		// $ref不能与其他字段并列
+		return s
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		s = &Schema{Type: "string", Format: "byte"}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		s = &Schema{Type: "array", Items: b.schemaOf(t.Elem())}
+	case t.Kind() == reflect.Map:
+		s = &Schema{Type: "object", AdditionalProperties: b.schemaOf(t.Elem())}
+	case t.Kind() == reflect.Interface:
+		s = &Schema{}
+	default:
+		s = primitiveSchema(t.Kind())
+	}
+	s.Nullable = nullable && s.Type != ""
+	return s
+}
+
+func primitiveSchema(kind reflect.Kind) *Schema {
+	switch kind {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// component 生成结构体的schema并返回其在components中的名称
+func (b *schemaBuilder) component(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := b.components[name]; taken || name == "" {
+		pkg := t.PkgPath()
+		name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+	}
+	b.names[t] = name
+	// 先占位 防止自引用的类型无限递归
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	b.components[name] = s
+
+	b.addFields(s, t, "json")
+	return name
+}
+
+// addFields 按tag生成字段 匿名嵌入的结构体字段展开到外层
+func (b *schemaBuilder) addFields(s *Schema, t reflect.Type, tagKey string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts := fieldName(f, tagKey)
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				b.addFields(s, ft, tagKey)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fs := b.schemaOf(ft)
+		required := applyBinding(fs, f.Tag.Get("binding"))
+		if def, ok := opts["default"]; ok {
+			fs.Default = parseDefault(fs.Type, def)
+		}
+		if f.Tag.Get("time_format") != "" {
+			fs.Type, fs.Format = "string", "date-time"
+		}
+		s.Properties[name] = fs
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// fieldName 解析json或form标签 返回字段名与其余选项 如form:"page,default=1"
+func fieldName(f reflect.StructField, tagKey string) (string, map[string]string) {
+	tag := f.Tag.Get(tagKey)
+	parts := strings.Split(tag, ",")
+	opts := map[string]string{}
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		opts[k] = v
+	}
+	return parts[0], opts
+}
+
+// applyBinding 将validator的binding标签转换为schema约束 返回是否必填
+// dive之后的规则作用于数组元素
+func applyBinding(s *Schema, binding string) bool {
+	if binding == "" {
+		return false
+	}
+	required := false
+	target := s
+	for _, rule := range strings.Split(binding, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			if target == s {
+				required = true
+			}
+		case "dive":
+			if target.Items == nil {
+				return required
+			}
+			target = target.Items
+		case "email":
+			target.Format = "email"
+		case "url":
+			target.Format = "uri"
+		case "uuid":
+			target.Format = "uuid"
+		case "oneof":
+			target.Enum = strings.Fields(param)
+		case "min", "max", "len", "gte", "lte":
+			applyRange(target, name, param)
+		}
+	}
+	return required
+}
+
+func applyRange(s *Schema, rule, param string) {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+	isMin := rule == "min" || rule == "gte" || rule == "len"
+	isMax := rule == "max" || rule == "lte" || rule == "len"
+	i := int(n)
+
+	switch s.Type {
+	case "string":
+		if isMin {
+			s.MinLength = &i
+		}
+		if isMax {
+			s.MaxLength = &i
+		}
+	case "array":
+		if isMin {
+			s.MinItems = &i
+		}
+		if isMax {
+			s.MaxItems = &i
+		}
+	case "integer", "number":
+		if isMin {
+			s.Minimum = &n
+		}
+		if isMax {
+			s.Maximum = &n
+		}
+	}
+}
+
+// parseDefault 按类型转换form标签中的默认值
+func parseDefault(typ, v string) any {
+	switch typ {
+	case "integer":
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return v
+}
@@ -0,0 +1,388 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// Operation 单个接口的文档 Path为/api之后的gin路由 如/v1/teams/:id/webhooks
+type Operation struct {
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Tags        []string
+	// 需要携带access token
+	Auth bool
+	// 服务间调用 使用HTTP Basic认证
+	ClientAuth bool
+	// 请求头 名称 -> 说明 均为必填
+	Headers map[string]string
+	// 查询参数 按form标签生成
+	Query any
+	// 请求体 按json标签生成
+	Request any
+	// 请求体为表单 Request按form标签生成
+	FormRequest bool
+	// 成功响应中的data 为nil时不返回data
+	Response any
+	// 成功时直接返回Response 不使用统一响应格式
+	RawResponse bool
+	// 可能返回的业务错误 按HTTP状态码分组
+	Errors []codes.ErrCode
+}
+
+var (
+	registryMu sync.Mutex
+	operations = map[string]Operation{}
+)
+
+// Register 注册接口文档 同一接口重复注册时以后注册的为准
+func Register(ops ...Operation) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for _, op := range ops {
+		operations[routeKey(op.Method, op.Path)] = op
+	}
+}
+
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// Document OpenAPI 3.0 文档
+type Document struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       Info                                   `json:"info"`
+	Servers    []Server                               `json:"servers"`
+	Paths      map[string]map[string]*operationObject `json:"paths"`
+	Components Components                             `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// operationObject OpenAPI中的Operation Object
+type operationObject struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+const (
+	// PathPrefix 文档覆盖的路由前缀
+	PathPrefix = "/api"
+
+	securityBearer = "bearerAuth"
+	securityBasic  = "basicAuth"
+	jsonMediaType  = "application/json"
+	formMediaType  = "application/x-www-form-urlencoded"
+)
+
+// 不需要文档的路由
+var excludedPrefixes = []string{PathPrefix + "/dev/", PathPrefix + "/openapi.json", PathPrefix + "/docs"}
+
+// Build 按已注册的gin路由和接口文档生成OpenAPI文档
+// drift为路由与文档不一致之处 未编写文档的路由不会出现在文档中
+func Build(routes gin.RoutesInfo) (doc *Document, drift []string) {
+	registryMu.Lock()
+	ops := make(map[string]Operation, len(operations))
+	for k, v := range operations {
+		ops[k] = v
+	}
+	registryMu.Unlock()
+
+	b := newSchemaBuilder()
+	doc = &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "SaaS Scaffold API", Version: "1.0.0"},
+		Servers: []Server{{URL: PathPrefix}},
+		Paths:   map[string]map[string]*operationObject{},
+	}
+
+	seen := map[string]bool{}
+	for _, route := range routes {
+		if !documented(route.Path) {
+			continue
+		}
+		path := strings.TrimPrefix(route.Path, PathPrefix)
+		key := routeKey(route.Method, path)
+		op, ok := ops[key]
+		if !ok {
+			drift = append(drift, "接口缺少文档: "+key)
+			continue
+		}
+		seen[key] = true
+
+		openapiPath := toOpenAPIPath(path)
+		if doc.Paths[openapiPath] == nil {
+			doc.Paths[openapiPath] = map[string]*operationObject{}
+		}
+		doc.Paths[openapiPath][strings.ToLower(route.Method)] = b.operation(op, path)
+	}
+	for key := range ops {
+		if !seen[key] {
+			drift = append(drift, "文档中的接口不存在: "+key)
+		}
+	}
+	sort.Strings(drift)
+
+	b.components["ErrorResponse"] = errorResponseSchema()
+	b.components["Problem"] = problemSchema()
+	doc.Components = Components{
+		Schemas: b.components,
+		SecuritySchemes: map[string]SecurityScheme{
+			securityBearer: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			securityBasic:  {Type: "http", Scheme: "basic"},
+		},
+	}
+	return doc, drift
+}
+
+func documented(path string) bool {
+	if !strings.HasPrefix(path, PathPrefix+"/") {
+		return false
+	}
+	for _, prefix := range excludedPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// toOpenAPIPath /teams/:id -> /teams/{id}
+func toOpenAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func operationID(method, path string) string {
+	id := strings.ToLower(method)
+	for _, seg := range strings.Split(path, "/") {
+		seg = strings.TrimLeft(seg, ":*")
+		if seg != "" {
+			id += "_" + seg
+		}
+	}
+	return id
+}
+
+func (b *schemaBuilder) operation(op Operation, path string) *operationObject {
+	out := &operationObject{
+		OperationID: operationID(op.Method, path),
+		Summary:     op.Summary,
+		Description: op.Description,
+		Tags:        op.Tags,
+		Responses:   map[string]Response{},
+	}
+
+	for _, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			out.Parameters = append(out.Parameters, Parameter{Name: seg[1:], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+	headers := make([]string, 0, len(op.Headers))
+	for name := range op.Headers {
+		headers = append(headers, name)
+	}
+	sort.Strings(headers)
+	for _, name := range headers {
+		out.Parameters = append(out.Parameters, Parameter{Name: name, In: "header", Description: op.Headers[name], Required: true, Schema: &Schema{Type: "string"}})
+	}
+	if op.Query != nil {
+		out.Parameters = append(out.Parameters, b.queryParameters(reflect.TypeOf(op.Query))...)
+	}
+
+	if op.Request != nil {
+		out.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{jsonMediaType: {Schema: b.schemaOf(reflect.TypeOf(op.Request))}},
+		}
+		if op.FormRequest {
+			out.RequestBody.Content = map[string]MediaType{formMediaType: {Schema: b.formSchema(reflect.TypeOf(op.Request))}}
+		}
+	}
+
+	success := &Schema{Type: "object", Properties: map[string]*Schema{
+		"code":    {Type: "integer", Format: "int32"},
+		"message": {Type: "string"},
+	}, Required: []string{"code", "message"}}
+	if op.Response != nil {
+		success.Properties["data"] = b.schemaOf(reflect.TypeOf(op.Response))
+	}
+	if op.RawResponse && op.Response != nil {
+		success = b.schemaOf(reflect.TypeOf(op.Response))
+	}
+	out.Responses[strconv.Itoa(http.StatusOK)] = Response{
+		Description: "成功",
+		Content:     map[string]MediaType{jsonMediaType: {Schema: success}},
+	}
+
+	errs := append([]codes.ErrCode(nil), op.Errors...)
+	if op.Request != nil || op.Query != nil {
+		errs = append(errs, codes.ErrValidationFailed)
+	}
+	if op.Auth {
+		errs = append(errs, codes.ErrUnauthorized, codes.ErrTokenInvalid, codes.ErrTokenExpired)
+		out.Security = []map[string][]string{{securityBearer: {}}}
+	}
+	if op.ClientAuth {
+		out.Security = append(out.Security, map[string][]string{securityBasic: {}})
+	}
+	errs = append(errs, codes.ErrInternal)
+	for status, resp := range errorResponses(errs) {
+		out.Responses[status] = resp
+	}
+	return out
+}
+
+// formSchema 按form标签生成表单请求体
+func (b *schemaBuilder) formSchema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	b.addFields(s, t, "form")
+	return s
+}
+
+// queryParameters 按form标签生成查询参数
+func (b *schemaBuilder) queryParameters(t reflect.Type) []Parameter {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s := &Schema{Properties: map[string]*Schema{}}
+	b.addFields(s, t, "form")
+
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		params = append(params, Parameter{Name: name, In: "query", Required: required[name], Schema: s.Properties[name]})
+	}
+	return params
+}
+
+// errorResponses 错误码按HTTP状态码分组 描述中列出所有可能的错误码
+func errorResponses(errs []codes.ErrCode) map[string]Response {
+	byStatus := map[int][]codes.ErrCode{}
+	for _, e := range errs {
+		status := e.HTTPStatus()
+		dup := false
+		for _, existing := range byStatus[status] {
+			dup = dup || existing.Code == e.Code
+		}
+		if !dup {
+			byStatus[status] = append(byStatus[status], e)
+		}
+	}
+
+	out := make(map[string]Response, len(byStatus))
+	for status, list := range byStatus {
+		sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+		lines := make([]string, 0, len(list))
+		for _, e := range list {
+			lines = append(lines, strconv.Itoa(e.Code)+": "+e.Msg)
+		}
+		out[strconv.Itoa(status)] = Response{
+			Description: strings.Join(lines, "\n\n"),
+			Content: map[string]MediaType{
+				jsonMediaType:            {Schema: &Schema{Ref: "#/components/schemas/ErrorResponse"}},
+				codes.ProblemContentType: {Schema: &Schema{Ref: "#/components/schemas/Problem"}},
+			},
+		}
+	}
+	return out
+}
+
+func errorResponseSchema() *Schema {
+	return &Schema{Type: "object", Required: []string{"code", "message"}, Properties: map[string]*Schema{
+		"code":       {Type: "integer", Format: "int32"},
+		"message":    {Type: "string"},
+		"details":    {Type: "object", AdditionalProperties: &Schema{}},
+		"request_id": {Type: "string"},
+	}}
+}
+
+func problemSchema() *Schema {
+	return &Schema{Type: "object", Required: []string{"type", "title", "status", "code"}, Properties: map[string]*Schema{
+		"type":     {Type: "string"},
+		"title":    {Type: "string"},
+		"status":   {Type: "integer", Format: "int32"},
+		"detail":   {Type: "string"},
+		"instance": {Type: "string"},
+		"code":     {Type: "integer", Format: "int32"},
+		"details":  {Type: "object", AdditionalProperties: &Schema{}},
+		"invalid-params": {Type: "array", Items: &Schema{Type: "object", Properties: map[string]*Schema{
+			"name":   {Type: "string"},
+			"reason": {Type: "string"},
+		}}},
+	}}
+}
@@ -4,6 +4,9 @@
 package orm
 
 var TableNames = struct {
+	AuditLogs         string
+	EmailDeliveries   string
+	EmailEvents       string
 	Plans             string
 	ProjectMembers    string
 	Projects          string
@@ -12,7 +15,12 @@ var TableNames = struct {
 	UsageStats        string
 	UserSubscriptions string
 	Users             string
+	WebhookDeliveries string
+	WebhookEndpoints  string
 }{
+	AuditLogs:         "audit_logs",
+	EmailDeliveries:   "email_deliveries",
+	EmailEvents:       "email_events",
 	Plans:             "plans",
 	ProjectMembers:    "project_members",
 	Projects:          "projects",
@@ -21,4 +29,6 @@ var TableNames = struct {
 	UsageStats:        "usage_stats",
 	UserSubscriptions: "user_subscriptions",
 	Users:             "users",
+	WebhookDeliveries: "webhook_deliveries",
+	WebhookEndpoints:  "webhook_endpoints",
 }
@@ -0,0 +1,1009 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// EmailDelivery is an object representing the database table.
+type EmailDelivery struct {
+	MessageID         string      `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	Provider          string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	ProviderMessageID null.String `boil:"provider_message_id" json:"provider_message_id,omitempty" toml:"provider_message_id" yaml:"provider_message_id,omitempty"`
+	Recipient         string      `boil:"recipient" json:"recipient" toml:"recipient" yaml:"recipient"`
+	Subject           string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Template          null.String `boil:"template" json:"template,omitempty" toml:"template" yaml:"template,omitempty"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Error             null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *emailDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L emailDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EmailDeliveryColumns = struct {
+	MessageID         string
+	Provider          string
+	ProviderMessageID string
+	Recipient         string
+	Subject           string
+	Template          string
+	Status            string
+	Error             string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	MessageID:         "message_id",
+	Provider:          "provider",
+	ProviderMessageID: "provider_message_id",
+	Recipient:         "recipient",
+	Subject:           "subject",
+	Template:          "template",
+	Status:            "status",
+	Error:             "error",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+}
+
+var EmailDeliveryTableColumns = struct {
+	MessageID         string
+	Provider          string
+	ProviderMessageID string
+	Recipient         string
+	Subject           string
+	Template          string
+	Status            string
+	Error             string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	MessageID:         "email_deliveries.message_id",
+	Provider:          "email_deliveries.provider",
+	ProviderMessageID: "email_deliveries.provider_message_id",
+	Recipient:         "email_deliveries.recipient",
+	Subject:           "email_deliveries.subject",
+	Template:          "email_deliveries.template",
+	Status:            "email_deliveries.status",
+	Error:             "email_deliveries.error",
+	CreatedAt:         "email_deliveries.created_at",
+	UpdatedAt:         "email_deliveries.updated_at",
+}
+
+// Generated where
+
+var EmailDeliveryWhere = struct {
+	MessageID         whereHelperstring
+	Provider          whereHelperstring
+	ProviderMessageID whereHelpernull_String
+	Recipient         whereHelperstring
+	Subject           whereHelperstring
+	Template          whereHelpernull_String
+	Status            whereHelperstring
+	Error             whereHelpernull_String
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+}{
+	MessageID:         whereHelperstring{field: "\"email_deliveries\".\"message_id\""},
+	Provider:          whereHelperstring{field: "\"email_deliveries\".\"provider\""},
+	ProviderMessageID: whereHelpernull_String{field: "\"email_deliveries\".\"provider_message_id\""},
+	Recipient:         whereHelperstring{field: "\"email_deliveries\".\"recipient\""},
+	Subject:           whereHelperstring{field: "\"email_deliveries\".\"subject\""},
+	Template:          whereHelpernull_String{field: "\"email_deliveries\".\"template\""},
+	Status:            whereHelperstring{field: "\"email_deliveries\".\"status\""},
+	Error:             whereHelpernull_String{field: "\"email_deliveries\".\"error\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"email_deliveries\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"email_deliveries\".\"updated_at\""},
+}
+
+// EmailDeliveryRels is where relationship names are stored.
+var EmailDeliveryRels = struct {
+}{}
+
+// emailDeliveryR is where relationships are stored.
+type emailDeliveryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*emailDeliveryR) NewStruct() *emailDeliveryR {
+	return &emailDeliveryR{}
+}
+
+// emailDeliveryL is where Load methods for each relationship are stored.
+type emailDeliveryL struct{}
+
+var (
+	emailDeliveryAllColumns            = []string{"message_id", "provider", "provider_message_id", "recipient", "subject", "template", "status", "error", "created_at", "updated_at"}
+	emailDeliveryColumnsWithoutDefault = []string{"message_id", "provider", "recipient", "subject"}
+	emailDeliveryColumnsWithDefault    = []string{"provider_message_id", "template", "status", "error", "created_at", "updated_at"}
+	emailDeliveryPrimaryKeyColumns     = []string{"message_id"}
+	emailDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// EmailDeliverySlice is an alias for a slice of pointers to EmailDelivery.
+	// This should almost always be used instead of []EmailDelivery.
+	EmailDeliverySlice []*EmailDelivery
+	// EmailDeliveryHook is the signature for custom EmailDelivery hook methods
+	EmailDeliveryHook func(context.Context, boil.ContextExecutor, *EmailDelivery) error
+
+	emailDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	emailDeliveryType                 = reflect.TypeOf(&EmailDelivery{})
+	emailDeliveryMapping              = queries.MakeStructMapping(emailDeliveryType)
+	emailDeliveryPrimaryKeyMapping, _ = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, emailDeliveryPrimaryKeyColumns)
+	emailDeliveryInsertCacheMut       sync.RWMutex
+	emailDeliveryInsertCache          = make(map[string]insertCache)
+	emailDeliveryUpdateCacheMut       sync.RWMutex
+	emailDeliveryUpdateCache          = make(map[string]updateCache)
+	emailDeliveryUpsertCacheMut       sync.RWMutex
+	emailDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var emailDeliveryAfterSelectMu sync.Mutex
+var emailDeliveryAfterSelectHooks []EmailDeliveryHook
+
+var emailDeliveryBeforeInsertMu sync.Mutex
+var emailDeliveryBeforeInsertHooks []EmailDeliveryHook
+var emailDeliveryAfterInsertMu sync.Mutex
+var emailDeliveryAfterInsertHooks []EmailDeliveryHook
+
+var emailDeliveryBeforeUpdateMu sync.Mutex
+var emailDeliveryBeforeUpdateHooks []EmailDeliveryHook
+var emailDeliveryAfterUpdateMu sync.Mutex
+var emailDeliveryAfterUpdateHooks []EmailDeliveryHook
+
+var emailDeliveryBeforeDeleteMu sync.Mutex
+var emailDeliveryBeforeDeleteHooks []EmailDeliveryHook
+var emailDeliveryAfterDeleteMu sync.Mutex
+var emailDeliveryAfterDeleteHooks []EmailDeliveryHook
+
+var emailDeliveryBeforeUpsertMu sync.Mutex
+var emailDeliveryBeforeUpsertHooks []EmailDeliveryHook
+var emailDeliveryAfterUpsertMu sync.Mutex
+var emailDeliveryAfterUpsertHooks []EmailDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *EmailDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *EmailDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *EmailDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *EmailDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *EmailDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *EmailDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *EmailDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *EmailDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *EmailDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEmailDeliveryHook registers your hook function for all future operations.
+func AddEmailDeliveryHook(hookPoint boil.HookPoint, emailDeliveryHook EmailDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		emailDeliveryAfterSelectMu.Lock()
+		emailDeliveryAfterSelectHooks = append(emailDeliveryAfterSelectHooks, emailDeliveryHook)
+		emailDeliveryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		emailDeliveryBeforeInsertMu.Lock()
+		emailDeliveryBeforeInsertHooks = append(emailDeliveryBeforeInsertHooks, emailDeliveryHook)
+		emailDeliveryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		emailDeliveryAfterInsertMu.Lock()
+		emailDeliveryAfterInsertHooks = append(emailDeliveryAfterInsertHooks, emailDeliveryHook)
+		emailDeliveryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		emailDeliveryBeforeUpdateMu.Lock()
+		emailDeliveryBeforeUpdateHooks = append(emailDeliveryBeforeUpdateHooks, emailDeliveryHook)
+		emailDeliveryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		emailDeliveryAfterUpdateMu.Lock()
+		emailDeliveryAfterUpdateHooks = append(emailDeliveryAfterUpdateHooks, emailDeliveryHook)
+		emailDeliveryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		emailDeliveryBeforeDeleteMu.Lock()
+		emailDeliveryBeforeDeleteHooks = append(emailDeliveryBeforeDeleteHooks, emailDeliveryHook)
+		emailDeliveryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		emailDeliveryAfterDeleteMu.Lock()
+		emailDeliveryAfterDeleteHooks = append(emailDeliveryAfterDeleteHooks, emailDeliveryHook)
+		emailDeliveryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		emailDeliveryBeforeUpsertMu.Lock()
+		emailDeliveryBeforeUpsertHooks = append(emailDeliveryBeforeUpsertHooks, emailDeliveryHook)
+		emailDeliveryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		emailDeliveryAfterUpsertMu.Lock()
+		emailDeliveryAfterUpsertHooks = append(emailDeliveryAfterUpsertHooks, emailDeliveryHook)
+		emailDeliveryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single emailDelivery record from the query.
+func (q emailDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EmailDelivery, error) {
+	o := &EmailDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for email_deliveries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all EmailDelivery records from the query.
+func (q emailDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (EmailDeliverySlice, error) {
+	var o []*EmailDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to EmailDelivery slice")
+	}
+
+	if len(emailDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EmailDelivery records in the query.
+func (q emailDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count email_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q emailDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if email_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// EmailDeliveries retrieves all the records using an executor.
+func EmailDeliveries(mods ...qm.QueryMod) emailDeliveryQuery {
+	mods = append(mods, qm.From("\"email_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"email_deliveries\".*"})
+	}
+
+	return emailDeliveryQuery{q}
+}
+
+// FindEmailDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEmailDelivery(ctx context.Context, exec boil.ContextExecutor, messageID string, selectCols ...string) (*EmailDelivery, error) {
+	emailDeliveryObj := &EmailDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"email_deliveries\" where \"message_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, messageID)
+
+	err := q.Bind(ctx, exec, emailDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from email_deliveries")
+	}
+
+	if err = emailDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return emailDeliveryObj, err
+	}
+
+	return emailDeliveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EmailDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no email_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	emailDeliveryInsertCacheMut.RLock()
+	cache, cached := emailDeliveryInsertCache[key]
+	emailDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			emailDeliveryAllColumns,
+			emailDeliveryColumnsWithDefault,
+			emailDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"email_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"email_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into email_deliveries")
+	}
+
+	if !cached {
+		emailDeliveryInsertCacheMut.Lock()
+		emailDeliveryInsertCache[key] = cache
+		emailDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the EmailDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EmailDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	emailDeliveryUpdateCacheMut.RLock()
+	cache, cached := emailDeliveryUpdateCache[key]
+	emailDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			emailDeliveryAllColumns,
+			emailDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update email_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"email_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, emailDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, append(wl, emailDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update email_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for email_deliveries")
+	}
+
+	if !cached {
+		emailDeliveryUpdateCacheMut.Lock()
+		emailDeliveryUpdateCache[key] = cache
+		emailDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q emailDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for email_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for email_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EmailDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"email_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, emailDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in emailDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all emailDelivery")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EmailDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no email_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	emailDeliveryUpsertCacheMut.RLock()
+	cache, cached := emailDeliveryUpsertCache[key]
+	emailDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			emailDeliveryAllColumns,
+			emailDeliveryColumnsWithDefault,
+			emailDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			emailDeliveryAllColumns,
+			emailDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert email_deliveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(emailDeliveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(emailDeliveryPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert email_deliveries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(emailDeliveryPrimaryKeyColumns))
+			copy(conflict, emailDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"email_deliveries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(emailDeliveryType, emailDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert email_deliveries")
+	}
+
+	if !cached {
+		emailDeliveryUpsertCacheMut.Lock()
+		emailDeliveryUpsertCache[key] = cache
+		emailDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single EmailDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EmailDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no EmailDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), emailDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"email_deliveries\" WHERE \"message_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from email_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for email_deliveries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q emailDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no emailDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from email_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for email_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EmailDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(emailDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"email_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from emailDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for email_deliveries")
+	}
+
+	if len(emailDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EmailDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEmailDelivery(ctx, exec, o.MessageID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EmailDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EmailDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"email_deliveries\".* FROM \"email_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in EmailDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EmailDeliveryExists checks if the EmailDelivery row exists.
+func EmailDeliveryExists(ctx context.Context, exec boil.ContextExecutor, messageID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"email_deliveries\" where \"message_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, messageID)
+	}
+	row := exec.QueryRowContext(ctx, sql, messageID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if email_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the EmailDelivery row exists.
+func (o *EmailDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return EmailDeliveryExists(ctx, exec, o.MessageID)
+}
@@ -0,0 +1,978 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// EmailEvent is an object representing the database table.
+type EmailEvent struct {
+	EventID    string      `boil:"event_id" json:"event_id" toml:"event_id" yaml:"event_id"`
+	MessageID  string      `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	EventType  string      `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	Reason     null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	Payload    null.JSON   `boil:"payload" json:"payload,omitempty" toml:"payload" yaml:"payload,omitempty"`
+	OccurredAt time.Time   `boil:"occurred_at" json:"occurred_at" toml:"occurred_at" yaml:"occurred_at"`
+	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *emailEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L emailEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EmailEventColumns = struct {
+	EventID    string
+	MessageID  string
+	EventType  string
+	Reason     string
+	Payload    string
+	OccurredAt string
+	CreatedAt  string
+}{
+	EventID:    "event_id",
+	MessageID:  "message_id",
+	EventType:  "event_type",
+	Reason:     "reason",
+	Payload:    "payload",
+	OccurredAt: "occurred_at",
+	CreatedAt:  "created_at",
+}
+
+var EmailEventTableColumns = struct {
+	EventID    string
+	MessageID  string
+	EventType  string
+	Reason     string
+	Payload    string
+	OccurredAt string
+	CreatedAt  string
+}{
+	EventID:    "email_events.event_id",
+	MessageID:  "email_events.message_id",
+	EventType:  "email_events.event_type",
+	Reason:     "email_events.reason",
+	Payload:    "email_events.payload",
+	OccurredAt: "email_events.occurred_at",
+	CreatedAt:  "email_events.created_at",
+}
+
+// Generated where
+
+var EmailEventWhere = struct {
+	EventID    whereHelperstring
+	MessageID  whereHelperstring
+	EventType  whereHelperstring
+	Reason     whereHelpernull_String
+	Payload    whereHelpernull_JSON
+	OccurredAt whereHelpertime_Time
+	CreatedAt  whereHelpertime_Time
+}{
+	EventID:    whereHelperstring{field: "\"email_events\".\"event_id\""},
+	MessageID:  whereHelperstring{field: "\"email_events\".\"message_id\""},
+	EventType:  whereHelperstring{field: "\"email_events\".\"event_type\""},
+	Reason:     whereHelpernull_String{field: "\"email_events\".\"reason\""},
+	Payload:    whereHelpernull_JSON{field: "\"email_events\".\"payload\""},
+	OccurredAt: whereHelpertime_Time{field: "\"email_events\".\"occurred_at\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"email_events\".\"created_at\""},
+}
+
+// EmailEventRels is where relationship names are stored.
+var EmailEventRels = struct {
+}{}
+
+// emailEventR is where relationships are stored.
+type emailEventR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*emailEventR) NewStruct() *emailEventR {
+	return &emailEventR{}
+}
+
+// emailEventL is where Load methods for each relationship are stored.
+type emailEventL struct{}
+
+var (
+	emailEventAllColumns            = []string{"event_id", "message_id", "event_type", "reason", "payload", "occurred_at", "created_at"}
+	emailEventColumnsWithoutDefault = []string{"message_id", "event_type", "occurred_at"}
+	emailEventColumnsWithDefault    = []string{"event_id", "reason", "payload", "created_at"}
+	emailEventPrimaryKeyColumns     = []string{"message_id", "event_id"}
+	emailEventGeneratedColumns      = []string{}
+)
+
+type (
+	// EmailEventSlice is an alias for a slice of pointers to EmailEvent.
+	// This should almost always be used instead of []EmailEvent.
+	EmailEventSlice []*EmailEvent
+	// EmailEventHook is the signature for custom EmailEvent hook methods
+	EmailEventHook func(context.Context, boil.ContextExecutor, *EmailEvent) error
+
+	emailEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	emailEventType                 = reflect.TypeOf(&EmailEvent{})
+	emailEventMapping              = queries.MakeStructMapping(emailEventType)
+	emailEventPrimaryKeyMapping, _ = queries.BindMapping(emailEventType, emailEventMapping, emailEventPrimaryKeyColumns)
+	emailEventInsertCacheMut       sync.RWMutex
+	emailEventInsertCache          = make(map[string]insertCache)
+	emailEventUpdateCacheMut       sync.RWMutex
+	emailEventUpdateCache          = make(map[string]updateCache)
+	emailEventUpsertCacheMut       sync.RWMutex
+	emailEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var emailEventAfterSelectMu sync.Mutex
+var emailEventAfterSelectHooks []EmailEventHook
+
+var emailEventBeforeInsertMu sync.Mutex
+var emailEventBeforeInsertHooks []EmailEventHook
+var emailEventAfterInsertMu sync.Mutex
+var emailEventAfterInsertHooks []EmailEventHook
+
+var emailEventBeforeUpdateMu sync.Mutex
+var emailEventBeforeUpdateHooks []EmailEventHook
+var emailEventAfterUpdateMu sync.Mutex
+var emailEventAfterUpdateHooks []EmailEventHook
+
+var emailEventBeforeDeleteMu sync.Mutex
+var emailEventBeforeDeleteHooks []EmailEventHook
+var emailEventAfterDeleteMu sync.Mutex
+var emailEventAfterDeleteHooks []EmailEventHook
+
+var emailEventBeforeUpsertMu sync.Mutex
+var emailEventBeforeUpsertHooks []EmailEventHook
+var emailEventAfterUpsertMu sync.Mutex
+var emailEventAfterUpsertHooks []EmailEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *EmailEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *EmailEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *EmailEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *EmailEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *EmailEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *EmailEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *EmailEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *EmailEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *EmailEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range emailEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEmailEventHook registers your hook function for all future operations.
+func AddEmailEventHook(hookPoint boil.HookPoint, emailEventHook EmailEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		emailEventAfterSelectMu.Lock()
+		emailEventAfterSelectHooks = append(emailEventAfterSelectHooks, emailEventHook)
+		emailEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		emailEventBeforeInsertMu.Lock()
+		emailEventBeforeInsertHooks = append(emailEventBeforeInsertHooks, emailEventHook)
+		emailEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		emailEventAfterInsertMu.Lock()
+		emailEventAfterInsertHooks = append(emailEventAfterInsertHooks, emailEventHook)
+		emailEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		emailEventBeforeUpdateMu.Lock()
+		emailEventBeforeUpdateHooks = append(emailEventBeforeUpdateHooks, emailEventHook)
+		emailEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		emailEventAfterUpdateMu.Lock()
+		emailEventAfterUpdateHooks = append(emailEventAfterUpdateHooks, emailEventHook)
+		emailEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		emailEventBeforeDeleteMu.Lock()
+		emailEventBeforeDeleteHooks = append(emailEventBeforeDeleteHooks, emailEventHook)
+		emailEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		emailEventAfterDeleteMu.Lock()
+		emailEventAfterDeleteHooks = append(emailEventAfterDeleteHooks, emailEventHook)
+		emailEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		emailEventBeforeUpsertMu.Lock()
+		emailEventBeforeUpsertHooks = append(emailEventBeforeUpsertHooks, emailEventHook)
+		emailEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		emailEventAfterUpsertMu.Lock()
+		emailEventAfterUpsertHooks = append(emailEventAfterUpsertHooks, emailEventHook)
+		emailEventAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single emailEvent record from the query.
+func (q emailEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EmailEvent, error) {
+	o := &EmailEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for email_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all EmailEvent records from the query.
+func (q emailEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (EmailEventSlice, error) {
+	var o []*EmailEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to EmailEvent slice")
+	}
+
+	if len(emailEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EmailEvent records in the query.
+func (q emailEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count email_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q emailEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if email_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// EmailEvents retrieves all the records using an executor.
+func EmailEvents(mods ...qm.QueryMod) emailEventQuery {
+	mods = append(mods, qm.From("\"email_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"email_events\".*"})
+	}
+
+	return emailEventQuery{q}
+}
+
+// FindEmailEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEmailEvent(ctx context.Context, exec boil.ContextExecutor, messageID string, eventID string, selectCols ...string) (*EmailEvent, error) {
+	emailEventObj := &EmailEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"email_events\" where \"message_id\"=$1 AND \"event_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, messageID, eventID)
+
+	err := q.Bind(ctx, exec, emailEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from email_events")
+	}
+
+	if err = emailEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return emailEventObj, err
+	}
+
+	return emailEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EmailEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no email_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	emailEventInsertCacheMut.RLock()
+	cache, cached := emailEventInsertCache[key]
+	emailEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			emailEventAllColumns,
+			emailEventColumnsWithDefault,
+			emailEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(emailEventType, emailEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(emailEventType, emailEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"email_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"email_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into email_events")
+	}
+
+	if !cached {
+		emailEventInsertCacheMut.Lock()
+		emailEventInsertCache[key] = cache
+		emailEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the EmailEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EmailEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	emailEventUpdateCacheMut.RLock()
+	cache, cached := emailEventUpdateCache[key]
+	emailEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			emailEventAllColumns,
+			emailEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update email_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"email_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, emailEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(emailEventType, emailEventMapping, append(wl, emailEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update email_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for email_events")
+	}
+
+	if !cached {
+		emailEventUpdateCacheMut.Lock()
+		emailEventUpdateCache[key] = cache
+		emailEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q emailEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for email_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for email_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EmailEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"email_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, emailEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in emailEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all emailEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EmailEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no email_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(emailEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	emailEventUpsertCacheMut.RLock()
+	cache, cached := emailEventUpsertCache[key]
+	emailEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			emailEventAllColumns,
+			emailEventColumnsWithDefault,
+			emailEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			emailEventAllColumns,
+			emailEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert email_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(emailEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(emailEventPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert email_events, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(emailEventPrimaryKeyColumns))
+			copy(conflict, emailEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"email_events\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(emailEventType, emailEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(emailEventType, emailEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert email_events")
+	}
+
+	if !cached {
+		emailEventUpsertCacheMut.Lock()
+		emailEventUpsertCache[key] = cache
+		emailEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single EmailEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EmailEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no EmailEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), emailEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"email_events\" WHERE \"message_id\"=$1 AND \"event_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from email_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for email_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q emailEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no emailEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from email_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for email_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EmailEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(emailEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"email_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from emailEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for email_events")
+	}
+
+	if len(emailEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EmailEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEmailEvent(ctx, exec, o.MessageID, o.EventID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EmailEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EmailEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), emailEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"email_events\".* FROM \"email_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, emailEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in EmailEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EmailEventExists checks if the EmailEvent row exists.
+func EmailEventExists(ctx context.Context, exec boil.ContextExecutor, messageID string, eventID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"email_events\" where \"message_id\"=$1 AND \"event_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, messageID, eventID)
+	}
+	row := exec.QueryRowContext(ctx, sql, messageID, eventID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if email_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the EmailEvent row exists.
+func (o *EmailEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return EmailEventExists(ctx, exec, o.MessageID, o.EventID)
+}
@@ -38,6 +38,7 @@ type User struct {
 	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	LastLoginAt   null.Time   `boil:"last_login_at" json:"last_login_at,omitempty" toml:"last_login_at" yaml:"last_login_at,omitempty"`
 	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Locale        string      `boil:"locale" json:"locale" toml:"locale" yaml:"locale"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -58,6 +59,7 @@ var UserColumns = struct {
 	UpdatedAt     string
 	LastLoginAt   string
 	Status        string
+	Locale        string
 }{
 	UserID:        "user_id",
 	Email:         "email",
@@ -73,6 +75,7 @@ var UserColumns = struct {
 	UpdatedAt:     "updated_at",
 	LastLoginAt:   "last_login_at",
 	Status:        "status",
+	Locale:        "locale",
 }
 
 var UserTableColumns = struct {
@@ -90,6 +93,7 @@ var UserTableColumns = struct {
 	UpdatedAt     string
 	LastLoginAt   string
 	Status        string
+	Locale        string
 }{
 	UserID:        "users.user_id",
 	Email:         "users.email",
@@ -105,6 +109,7 @@ var UserTableColumns = struct {
 	UpdatedAt:     "users.updated_at",
 	LastLoginAt:   "users.last_login_at",
 	Status:        "users.status",
+	Locale:        "users.locale",
 }
 
 // Generated where
@@ -124,6 +129,7 @@ var UserWhere = struct {
 	UpdatedAt     whereHelpertime_Time
 	LastLoginAt   whereHelpernull_Time
 	Status        whereHelperstring
+	Locale        whereHelperstring
 }{
 	UserID:        whereHelperstring{field: "\"users\".\"user_id\""},
 	Email:         whereHelperstring{field: "\"users\".\"email\""},
@@ -139,6 +145,7 @@ var UserWhere = struct {
 	UpdatedAt:     whereHelpertime_Time{field: "\"users\".\"updated_at\""},
 	LastLoginAt:   whereHelpernull_Time{field: "\"users\".\"last_login_at\""},
 	Status:        whereHelperstring{field: "\"users\".\"status\""},
+	Locale:        whereHelperstring{field: "\"users\".\"locale\""},
 }
 
 // UserRels is where relationship names are stored.
@@ -158,9 +165,9 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"user_id", "email", "password_hash", "name", "username", "avatar_url", "email_verified", "github_id", "google_id", "gitlab_id", "created_at", "updated_at", "last_login_at", "status"}
+	userAllColumns            = []string{"user_id", "email", "password_hash", "name", "username", "avatar_url", "email_verified", "github_id", "google_id", "gitlab_id", "created_at", "updated_at", "last_login_at", "status", "locale"}
 	userColumnsWithoutDefault = []string{"email", "name"}
-	userColumnsWithDefault    = []string{"user_id", "password_hash", "username", "avatar_url", "email_verified", "github_id", "google_id", "gitlab_id", "created_at", "updated_at", "last_login_at", "status"}
+	userColumnsWithDefault    = []string{"user_id", "password_hash", "username", "avatar_url", "email_verified", "github_id", "google_id", "gitlab_id", "created_at", "updated_at", "last_login_at", "status", "locale"}
 	userPrimaryKeyColumns     = []string{"user_id"}
 	userGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1101 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookDelivery is an object representing the database table.
+type WebhookDelivery struct {
+	DeliveryID   string      `boil:"delivery_id" json:"delivery_id" toml:"delivery_id" yaml:"delivery_id"`
+	OwnerID      string      `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	WebhookID    string      `boil:"webhook_id" json:"webhook_id" toml:"webhook_id" yaml:"webhook_id"`
+	TeamID       string      `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	EventID      string      `boil:"event_id" json:"event_id" toml:"event_id" yaml:"event_id"`
+	EventType    string      `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	Payload      types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status       string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts     int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	ResponseCode null.Int    `boil:"response_code" json:"response_code,omitempty" toml:"response_code" yaml:"response_code,omitempty"`
+	ResponseBody null.String `boil:"response_body" json:"response_body,omitempty" toml:"response_body" yaml:"response_body,omitempty"`
+	Error        null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	DurationMS   null.Int    `boil:"duration_ms" json:"duration_ms,omitempty" toml:"duration_ms" yaml:"duration_ms,omitempty"`
+	IsRedelivery bool        `boil:"is_redelivery" json:"is_redelivery" toml:"is_redelivery" yaml:"is_redelivery"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeliveredAt  null.Time   `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+
+	R *webhookDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookDeliveryColumns = struct {
+	DeliveryID   string
+	OwnerID      string
+	WebhookID    string
+	TeamID       string
+	EventID      string
+	EventType    string
+	Payload      string
+	Status       string
+	Attempts     string
+	ResponseCode string
+	ResponseBody string
+	Error        string
+	DurationMS   string
+	IsRedelivery string
+	CreatedAt    string
+	DeliveredAt  string
+}{
+	DeliveryID:   "delivery_id",
+	OwnerID:      "owner_id",
+	WebhookID:    "webhook_id",
+	TeamID:       "team_id",
+	EventID:      "event_id",
+	EventType:    "event_type",
+	Payload:      "payload",
+	Status:       "status",
+	Attempts:     "attempts",
+	ResponseCode: "response_code",
+	ResponseBody: "response_body",
+	Error:        "error",
+	DurationMS:   "duration_ms",
+	IsRedelivery: "is_redelivery",
+	CreatedAt:    "created_at",
+	DeliveredAt:  "delivered_at",
+}
+
+var WebhookDeliveryTableColumns = struct {
+	DeliveryID   string
+	OwnerID      string
+	WebhookID    string
+	TeamID       string
+	EventID      string
+	EventType    string
+	Payload      string
+	Status       string
+	Attempts     string
+	ResponseCode string
+	ResponseBody string
+	Error        string
+	DurationMS   string
+	IsRedelivery string
+	CreatedAt    string
+	DeliveredAt  string
+}{
+	DeliveryID:   "webhook_deliveries.delivery_id",
+	OwnerID:      "webhook_deliveries.owner_id",
+	WebhookID:    "webhook_deliveries.webhook_id",
+	TeamID:       "webhook_deliveries.team_id",
+	EventID:      "webhook_deliveries.event_id",
+	EventType:    "webhook_deliveries.event_type",
+	Payload:      "webhook_deliveries.payload",
+	Status:       "webhook_deliveries.status",
+	Attempts:     "webhook_deliveries.attempts",
+	ResponseCode: "webhook_deliveries.response_code",
+	ResponseBody: "webhook_deliveries.response_body",
+	Error:        "webhook_deliveries.error",
+	DurationMS:   "webhook_deliveries.duration_ms",
+	IsRedelivery: "webhook_deliveries.is_redelivery",
+	CreatedAt:    "webhook_deliveries.created_at",
+	DeliveredAt:  "webhook_deliveries.delivered_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var WebhookDeliveryWhere = struct {
+	DeliveryID   whereHelperstring
+	OwnerID      whereHelperstring
+	WebhookID    whereHelperstring
+	TeamID       whereHelperstring
+	EventID      whereHelperstring
+	EventType    whereHelperstring
+	Payload      whereHelpertypes_JSON
+	Status       whereHelperstring
+	Attempts     whereHelperint
+	ResponseCode whereHelpernull_Int
+	ResponseBody whereHelpernull_String
+	Error        whereHelpernull_String
+	DurationMS   whereHelpernull_Int
+	IsRedelivery whereHelperbool
+	CreatedAt    whereHelpertime_Time
+	DeliveredAt  whereHelpernull_Time
+}{
+	DeliveryID:   whereHelperstring{field: "\"webhook_deliveries\".\"delivery_id\""},
+	OwnerID:      whereHelperstring{field: "\"webhook_deliveries\".\"owner_id\""},
+	WebhookID:    whereHelperstring{field: "\"webhook_deliveries\".\"webhook_id\""},
+	TeamID:       whereHelperstring{field: "\"webhook_deliveries\".\"team_id\""},
+	EventID:      whereHelperstring{field: "\"webhook_deliveries\".\"event_id\""},
+	EventType:    whereHelperstring{field: "\"webhook_deliveries\".\"event_type\""},
+	Payload:      whereHelpertypes_JSON{field: "\"webhook_deliveries\".\"payload\""},
+	Status:       whereHelperstring{field: "\"webhook_deliveries\".\"status\""},
+	Attempts:     whereHelperint{field: "\"webhook_deliveries\".\"attempts\""},
+	ResponseCode: whereHelpernull_Int{field: "\"webhook_deliveries\".\"response_code\""},
+	ResponseBody: whereHelpernull_String{field: "\"webhook_deliveries\".\"response_body\""},
+	Error:        whereHelpernull_String{field: "\"webhook_deliveries\".\"error\""},
+	DurationMS:   whereHelpernull_Int{field: "\"webhook_deliveries\".\"duration_ms\""},
+	IsRedelivery: whereHelperbool{field: "\"webhook_deliveries\".\"is_redelivery\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"webhook_deliveries\".\"created_at\""},
+	DeliveredAt:  whereHelpernull_Time{field: "\"webhook_deliveries\".\"delivered_at\""},
+}
+
+// WebhookDeliveryRels is where relationship names are stored.
+var WebhookDeliveryRels = struct {
+}{}
+
+// webhookDeliveryR is where relationships are stored.
+type webhookDeliveryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookDeliveryR) NewStruct() *webhookDeliveryR {
+	return &webhookDeliveryR{}
+}
+
+// webhookDeliveryL is where Load methods for each relationship are stored.
+type webhookDeliveryL struct{}
+
+var (
+	webhookDeliveryAllColumns            = []string{"delivery_id", "owner_id", "webhook_id", "team_id", "event_id", "event_type", "payload", "status", "attempts", "response_code", "response_body", "error", "duration_ms", "is_redelivery", "created_at", "delivered_at"}
+	webhookDeliveryColumnsWithoutDefault = []string{"owner_id", "webhook_id", "team_id", "event_id", "event_type", "payload"}
+	webhookDeliveryColumnsWithDefault    = []string{"delivery_id", "status", "attempts", "response_code", "response_body", "error", "duration_ms", "is_redelivery", "created_at", "delivered_at"}
+	webhookDeliveryPrimaryKeyColumns     = []string{"owner_id", "delivery_id"}
+	webhookDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookDeliverySlice is an alias for a slice of pointers to WebhookDelivery.
+	// This should almost always be used instead of []WebhookDelivery.
+	WebhookDeliverySlice []*WebhookDelivery
+	// WebhookDeliveryHook is the signature for custom WebhookDelivery hook methods
+	WebhookDeliveryHook func(context.Context, boil.ContextExecutor, *WebhookDelivery) error
+
+	webhookDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookDeliveryType                 = reflect.TypeOf(&WebhookDelivery{})
+	webhookDeliveryMapping              = queries.MakeStructMapping(webhookDeliveryType)
+	webhookDeliveryPrimaryKeyMapping, _ = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, webhookDeliveryPrimaryKeyColumns)
+	webhookDeliveryInsertCacheMut       sync.RWMutex
+	webhookDeliveryInsertCache          = make(map[string]insertCache)
+	webhookDeliveryUpdateCacheMut       sync.RWMutex
+	webhookDeliveryUpdateCache          = make(map[string]updateCache)
+	webhookDeliveryUpsertCacheMut       sync.RWMutex
+	webhookDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookDeliveryAfterSelectMu sync.Mutex
+var webhookDeliveryAfterSelectHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeInsertMu sync.Mutex
+var webhookDeliveryBeforeInsertHooks []WebhookDeliveryHook
+var webhookDeliveryAfterInsertMu sync.Mutex
+var webhookDeliveryAfterInsertHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeUpdateMu sync.Mutex
+var webhookDeliveryBeforeUpdateHooks []WebhookDeliveryHook
+var webhookDeliveryAfterUpdateMu sync.Mutex
+var webhookDeliveryAfterUpdateHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeDeleteMu sync.Mutex
+var webhookDeliveryBeforeDeleteHooks []WebhookDeliveryHook
+var webhookDeliveryAfterDeleteMu sync.Mutex
+var webhookDeliveryAfterDeleteHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeUpsertMu sync.Mutex
+var webhookDeliveryBeforeUpsertHooks []WebhookDeliveryHook
+var webhookDeliveryAfterUpsertMu sync.Mutex
+var webhookDeliveryAfterUpsertHooks []WebhookDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookDeliveryHook registers your hook function for all future operations.
+func AddWebhookDeliveryHook(hookPoint boil.HookPoint, webhookDeliveryHook WebhookDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookDeliveryAfterSelectMu.Lock()
+		webhookDeliveryAfterSelectHooks = append(webhookDeliveryAfterSelectHooks, webhookDeliveryHook)
+		webhookDeliveryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookDeliveryBeforeInsertMu.Lock()
+		webhookDeliveryBeforeInsertHooks = append(webhookDeliveryBeforeInsertHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookDeliveryAfterInsertMu.Lock()
+		webhookDeliveryAfterInsertHooks = append(webhookDeliveryAfterInsertHooks, webhookDeliveryHook)
+		webhookDeliveryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookDeliveryBeforeUpdateMu.Lock()
+		webhookDeliveryBeforeUpdateHooks = append(webhookDeliveryBeforeUpdateHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookDeliveryAfterUpdateMu.Lock()
+		webhookDeliveryAfterUpdateHooks = append(webhookDeliveryAfterUpdateHooks, webhookDeliveryHook)
+		webhookDeliveryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookDeliveryBeforeDeleteMu.Lock()
+		webhookDeliveryBeforeDeleteHooks = append(webhookDeliveryBeforeDeleteHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookDeliveryAfterDeleteMu.Lock()
+		webhookDeliveryAfterDeleteHooks = append(webhookDeliveryAfterDeleteHooks, webhookDeliveryHook)
+		webhookDeliveryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookDeliveryBeforeUpsertMu.Lock()
+		webhookDeliveryBeforeUpsertHooks = append(webhookDeliveryBeforeUpsertHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookDeliveryAfterUpsertMu.Lock()
+		webhookDeliveryAfterUpsertHooks = append(webhookDeliveryAfterUpsertHooks, webhookDeliveryHook)
+		webhookDeliveryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webhookDelivery record from the query.
+func (q webhookDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookDelivery, error) {
+	o := &WebhookDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for webhook_deliveries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookDelivery records from the query.
+func (q webhookDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookDeliverySlice, error) {
+	var o []*WebhookDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to WebhookDelivery slice")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookDelivery records in the query.
+func (q webhookDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count webhook_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if webhook_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebhookDeliveries retrieves all the records using an executor.
+func WebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	mods = append(mods, qm.From("\"webhook_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_deliveries\".*"})
+	}
+
+	return webhookDeliveryQuery{q}
+}
+
+// FindWebhookDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookDelivery(ctx context.Context, exec boil.ContextExecutor, ownerID string, deliveryID string, selectCols ...string) (*WebhookDelivery, error) {
+	webhookDeliveryObj := &WebhookDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_deliveries\" where \"owner_id\"=$1 AND \"delivery_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, ownerID, deliveryID)
+
+	err := q.Bind(ctx, exec, webhookDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from webhook_deliveries")
+	}
+
+	if err = webhookDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookDeliveryObj, err
+	}
+
+	return webhookDeliveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no webhook_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookDeliveryInsertCacheMut.RLock()
+	cache, cached := webhookDeliveryInsertCache[key]
+	webhookDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryColumnsWithDefault,
+			webhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryInsertCacheMut.Lock()
+		webhookDeliveryInsertCache[key] = cache
+		webhookDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebhookDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookDeliveryUpdateCacheMut.RLock()
+	cache, cached := webhookDeliveryUpdateCache[key]
+	webhookDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update webhook_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, append(wl, webhookDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update webhook_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryUpdateCacheMut.Lock()
+		webhookDeliveryUpdateCache[key] = cache
+		webhookDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in webhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all webhookDelivery")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no webhook_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookDeliveryUpsertCacheMut.RLock()
+	cache, cached := webhookDeliveryUpsertCache[key]
+	webhookDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryColumnsWithDefault,
+			webhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert webhook_deliveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookDeliveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookDeliveryPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert webhook_deliveries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookDeliveryPrimaryKeyColumns))
+			copy(conflict, webhookDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_deliveries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryUpsertCacheMut.Lock()
+		webhookDeliveryUpsertCache[key] = cache
+		webhookDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebhookDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no WebhookDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_deliveries\" WHERE \"owner_id\"=$1 AND \"delivery_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for webhook_deliveries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no webhookDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookDelivery(ctx, exec, o.OwnerID, o.DeliveryID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_deliveries\".* FROM \"webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in WebhookDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookDeliveryExists checks if the WebhookDelivery row exists.
+func WebhookDeliveryExists(ctx context.Context, exec boil.ContextExecutor, ownerID string, deliveryID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_deliveries\" where \"owner_id\"=$1 AND \"delivery_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, ownerID, deliveryID)
+	}
+	row := exec.QueryRowContext(ctx, sql, ownerID, deliveryID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if webhook_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookDelivery row exists.
+func (o *WebhookDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookDeliveryExists(ctx, exec, o.OwnerID, o.DeliveryID)
+}
@@ -0,0 +1,1038 @@
+// Code generated by SQLBoiler 4.19.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookEndpoint is an object representing the database table.
+type WebhookEndpoint struct {
+	WebhookID   string            `boil:"webhook_id" json:"webhook_id" toml:"webhook_id" yaml:"webhook_id"`
+	OwnerID     string            `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	TeamID      string            `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	URL         string            `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret      string            `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	EventTypes  types.StringArray `boil:"event_types" json:"event_types" toml:"event_types" yaml:"event_types"`
+	Description null.String       `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	CreatedBy   string            `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt   time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Status      string            `boil:"status" json:"status" toml:"status" yaml:"status"`
+
+	R *webhookEndpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEndpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEndpointColumns = struct {
+	WebhookID   string
+	OwnerID     string
+	TeamID      string
+	URL         string
+	Secret      string
+	EventTypes  string
+	Description string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	Status      string
+}{
+	WebhookID:   "webhook_id",
+	OwnerID:     "owner_id",
+	TeamID:      "team_id",
+	URL:         "url",
+	Secret:      "secret",
+	EventTypes:  "event_types",
+	Description: "description",
+	CreatedBy:   "created_by",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	Status:      "status",
+}
+
+var WebhookEndpointTableColumns = struct {
+	WebhookID   string
+	OwnerID     string
+	TeamID      string
+	URL         string
+	Secret      string
+	EventTypes  string
+	Description string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	Status      string
+}{
+	WebhookID:   "webhook_endpoints.webhook_id",
+	OwnerID:     "webhook_endpoints.owner_id",
+	TeamID:      "webhook_endpoints.team_id",
+	URL:         "webhook_endpoints.url",
+	Secret:      "webhook_endpoints.secret",
+	EventTypes:  "webhook_endpoints.event_types",
+	Description: "webhook_endpoints.description",
+	CreatedBy:   "webhook_endpoints.created_by",
+	CreatedAt:   "webhook_endpoints.created_at",
+	UpdatedAt:   "webhook_endpoints.updated_at",
+	Status:      "webhook_endpoints.status",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var WebhookEndpointWhere = struct {
+	WebhookID   whereHelperstring
+	OwnerID     whereHelperstring
+	TeamID      whereHelperstring
+	URL         whereHelperstring
+	Secret      whereHelperstring
+	EventTypes  whereHelpertypes_StringArray
+	Description whereHelpernull_String
+	CreatedBy   whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	Status      whereHelperstring
+}{
+	WebhookID:   whereHelperstring{field: "\"webhook_endpoints\".\"webhook_id\""},
+	OwnerID:     whereHelperstring{field: "\"webhook_endpoints\".\"owner_id\""},
+	TeamID:      whereHelperstring{field: "\"webhook_endpoints\".\"team_id\""},
+	URL:         whereHelperstring{field: "\"webhook_endpoints\".\"url\""},
+	Secret:      whereHelperstring{field: "\"webhook_endpoints\".\"secret\""},
+	EventTypes:  whereHelpertypes_StringArray{field: "\"webhook_endpoints\".\"event_types\""},
+	Description: whereHelpernull_String{field: "\"webhook_endpoints\".\"description\""},
+	CreatedBy:   whereHelperstring{field: "\"webhook_endpoints\".\"created_by\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"webhook_endpoints\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"webhook_endpoints\".\"updated_at\""},
+	Status:      whereHelperstring{field: "\"webhook_endpoints\".\"status\""},
+}
+
+// WebhookEndpointRels is where relationship names are stored.
+var WebhookEndpointRels = struct {
+}{}
+
+// webhookEndpointR is where relationships are stored.
+type webhookEndpointR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEndpointR) NewStruct() *webhookEndpointR {
+	return &webhookEndpointR{}
+}
+
+// webhookEndpointL is where Load methods for each relationship are stored.
+type webhookEndpointL struct{}
+
+var (
+	webhookEndpointAllColumns            = []string{"webhook_id", "owner_id", "team_id", "url", "secret", "event_types", "description", "created_by", "created_at", "updated_at", "status"}
+	webhookEndpointColumnsWithoutDefault = []string{"owner_id", "team_id", "url", "secret", "created_by"}
+	webhookEndpointColumnsWithDefault    = []string{"webhook_id", "event_types", "description", "created_at", "updated_at", "status"}
+	webhookEndpointPrimaryKeyColumns     = []string{"owner_id", "webhook_id"}
+	webhookEndpointGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEndpointSlice is an alias for a slice of pointers to WebhookEndpoint.
+	// This should almost always be used instead of []WebhookEndpoint.
+	WebhookEndpointSlice []*WebhookEndpoint
+	// WebhookEndpointHook is the signature for custom WebhookEndpoint hook methods
+	WebhookEndpointHook func(context.Context, boil.ContextExecutor, *WebhookEndpoint) error
+
+	webhookEndpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEndpointType                 = reflect.TypeOf(&WebhookEndpoint{})
+	webhookEndpointMapping              = queries.MakeStructMapping(webhookEndpointType)
+	webhookEndpointPrimaryKeyMapping, _ = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, webhookEndpointPrimaryKeyColumns)
+	webhookEndpointInsertCacheMut       sync.RWMutex
+	webhookEndpointInsertCache          = make(map[string]insertCache)
+	webhookEndpointUpdateCacheMut       sync.RWMutex
+	webhookEndpointUpdateCache          = make(map[string]updateCache)
+	webhookEndpointUpsertCacheMut       sync.RWMutex
+	webhookEndpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookEndpointAfterSelectMu sync.Mutex
+var webhookEndpointAfterSelectHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeInsertMu sync.Mutex
+var webhookEndpointBeforeInsertHooks []WebhookEndpointHook
+var webhookEndpointAfterInsertMu sync.Mutex
+var webhookEndpointAfterInsertHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpdateMu sync.Mutex
+var webhookEndpointBeforeUpdateHooks []WebhookEndpointHook
+var webhookEndpointAfterUpdateMu sync.Mutex
+var webhookEndpointAfterUpdateHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeDeleteMu sync.Mutex
+var webhookEndpointBeforeDeleteHooks []WebhookEndpointHook
+var webhookEndpointAfterDeleteMu sync.Mutex
+var webhookEndpointAfterDeleteHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpsertMu sync.Mutex
+var webhookEndpointBeforeUpsertHooks []WebhookEndpointHook
+var webhookEndpointAfterUpsertMu sync.Mutex
+var webhookEndpointAfterUpsertHooks []WebhookEndpointHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookEndpoint) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookEndpoint) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookEndpoint) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookEndpoint) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookEndpoint) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookEndpoint) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookEndpoint) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookEndpoint) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookEndpoint) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookEndpointHook registers your hook function for all future operations.
+func AddWebhookEndpointHook(hookPoint boil.HookPoint, webhookEndpointHook WebhookEndpointHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookEndpointAfterSelectMu.Lock()
+		webhookEndpointAfterSelectHooks = append(webhookEndpointAfterSelectHooks, webhookEndpointHook)
+		webhookEndpointAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookEndpointBeforeInsertMu.Lock()
+		webhookEndpointBeforeInsertHooks = append(webhookEndpointBeforeInsertHooks, webhookEndpointHook)
+		webhookEndpointBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookEndpointAfterInsertMu.Lock()
+		webhookEndpointAfterInsertHooks = append(webhookEndpointAfterInsertHooks, webhookEndpointHook)
+		webhookEndpointAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookEndpointBeforeUpdateMu.Lock()
+		webhookEndpointBeforeUpdateHooks = append(webhookEndpointBeforeUpdateHooks, webhookEndpointHook)
+		webhookEndpointBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookEndpointAfterUpdateMu.Lock()
+		webhookEndpointAfterUpdateHooks = append(webhookEndpointAfterUpdateHooks, webhookEndpointHook)
+		webhookEndpointAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookEndpointBeforeDeleteMu.Lock()
+		webhookEndpointBeforeDeleteHooks = append(webhookEndpointBeforeDeleteHooks, webhookEndpointHook)
+		webhookEndpointBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookEndpointAfterDeleteMu.Lock()
+		webhookEndpointAfterDeleteHooks = append(webhookEndpointAfterDeleteHooks, webhookEndpointHook)
+		webhookEndpointAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookEndpointBeforeUpsertMu.Lock()
+		webhookEndpointBeforeUpsertHooks = append(webhookEndpointBeforeUpsertHooks, webhookEndpointHook)
+		webhookEndpointBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookEndpointAfterUpsertMu.Lock()
+		webhookEndpointAfterUpsertHooks = append(webhookEndpointAfterUpsertHooks, webhookEndpointHook)
+		webhookEndpointAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webhookEndpoint record from the query.
+func (q webhookEndpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookEndpoint, error) {
+	o := &WebhookEndpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for webhook_endpoints")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookEndpoint records from the query.
+func (q webhookEndpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookEndpointSlice, error) {
+	var o []*WebhookEndpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to WebhookEndpoint slice")
+	}
+
+	if len(webhookEndpointAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookEndpoint records in the query.
+func (q webhookEndpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count webhook_endpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEndpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if webhook_endpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebhookEndpoints retrieves all the records using an executor.
+func WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	mods = append(mods, qm.From("\"webhook_endpoints\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_endpoints\".*"})
+	}
+
+	return webhookEndpointQuery{q}
+}
+
+// FindWebhookEndpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEndpoint(ctx context.Context, exec boil.ContextExecutor, ownerID string, webhookID string, selectCols ...string) (*WebhookEndpoint, error) {
+	webhookEndpointObj := &WebhookEndpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_endpoints\" where \"owner_id\"=$1 AND \"webhook_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, ownerID, webhookID)
+
+	err := q.Bind(ctx, exec, webhookEndpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from webhook_endpoints")
+	}
+
+	if err = webhookEndpointObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookEndpointObj, err
+	}
+
+	return webhookEndpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEndpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no webhook_endpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEndpointInsertCacheMut.RLock()
+	cache, cached := webhookEndpointInsertCache[key]
+	webhookEndpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_endpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_endpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointInsertCacheMut.Lock()
+		webhookEndpointInsertCache[key] = cache
+		webhookEndpointInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebhookEndpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEndpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookEndpointUpdateCacheMut.RLock()
+	cache, cached := webhookEndpointUpdateCache[key]
+	webhookEndpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update webhook_endpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookEndpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update webhook_endpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpdateCacheMut.Lock()
+		webhookEndpointUpdateCache[key] = cache
+		webhookEndpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEndpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEndpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookEndpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all webhookEndpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEndpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no webhook_endpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEndpointUpsertCacheMut.RLock()
+	cache, cached := webhookEndpointUpsertCache[key]
+	webhookEndpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert webhook_endpoints, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookEndpointAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookEndpointPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert webhook_endpoints, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookEndpointPrimaryKeyColumns))
+			copy(conflict, webhookEndpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_endpoints\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpsertCacheMut.Lock()
+		webhookEndpointUpsertCache[key] = cache
+		webhookEndpointUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebhookEndpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEndpoint) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no WebhookEndpoint provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEndpointPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_endpoints\" WHERE \"owner_id\"=$1 AND \"webhook_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for webhook_endpoints")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEndpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no webhookEndpointQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEndpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookEndpointBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	if len(webhookEndpointAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEndpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookEndpoint(ctx, exec, o.OwnerID, o.WebhookID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEndpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEndpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_endpoints\".* FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in WebhookEndpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEndpointExists checks if the WebhookEndpoint row exists.
+func WebhookEndpointExists(ctx context.Context, exec boil.ContextExecutor, ownerID string, webhookID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_endpoints\" where \"owner_id\"=$1 AND \"webhook_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, ownerID, webhookID)
+	}
+	row := exec.QueryRowContext(ctx, sql, ownerID, webhookID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if webhook_endpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookEndpoint row exists.
+func (o *WebhookEndpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookEndpointExists(ctx, exec, o.OwnerID, o.WebhookID)
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 每处理该数量的请求清理一次过期的key
+const memoryCleanupEvery = 1024
+
+// MemoryLimiter 单实例内存限流 算法与RedisLimiter一致 用于测试及Redis不可用时兜底
+type MemoryLimiter struct {
+	mu    sync.Mutex
+	tats  map[string]time.Time
+	calls int
+	now   func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{tats: make(map[string]time.Time), now: time.Now}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	if limit.IsZero() {
+		return Result{Allowed: true}, nil
+	}
+
+	emission := limit.Period / time.Duration(limit.Rate)
+	tolerance := emission * time.Duration(limit.Rate)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	tat, ok := l.tats[key]
+	if !ok || tat.Before(now) {
+		tat = now
+	}
+
+	newTat := tat.Add(emission)
+	diff := now.Sub(newTat.Add(-tolerance))
+	if diff < 0 {
+		return Result{Limit: limit.Rate, RetryAfter: -diff, ResetAfter: tat.Sub(now)}, nil
+	}
+
+	l.tats[key] = newTat
+	return Result{
+		Allowed:    true,
+		Limit:      limit.Rate,
+		Remaining:  int(diff / emission),
+		ResetAfter: newTat.Sub(now),
+	}, nil
+}
+
+// cleanup 额度已完全恢复的key无需保留
+func (l *MemoryLimiter) cleanup(now time.Time) {
+	l.calls++
+	if l.calls < memoryCleanupEvery {
+		return
+	}
+	l.calls = 0
+	for key, tat := range l.tats {
+		if !tat.After(now) {
+			delete(l.tats, key)
+		}
+	}
+}
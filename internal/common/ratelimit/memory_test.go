@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(now *time.Time) *MemoryLimiter {
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestMemoryLimiterBurstThenRefill(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+	limit := Limit{Rate: 3, Period: 3 * time.Second}
+
+	for i := range 3 {
+		res, _ := l.Allow(context.Background(), "k", limit)
+		if !res.Allowed || res.Remaining != 2-i {
+			t.Fatalf("第%d次: %+v", i+1, res)
+		}
+	}
+	res, _ := l.Allow(context.Background(), "k", limit)
+	if res.Allowed || res.RetryAfter != time.Second {
+		t.Fatalf("超限后应拒绝并等待1s: %+v", res)
+	}
+
+	// 其他key不受影响
+	if res, _ := l.Allow(context.Background(), "other", limit); !res.Allowed {
+		t.Fatal("不同key应独立计数")
+	}
+
+	now = now.Add(time.Second)
+	if res, _ := l.Allow(context.Background(), "k", limit); !res.Allowed {
+		t.Fatal("恢复一个额度后应放行")
+	}
+}
+
+func TestMemoryLimiterCleanup(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+	limit := Limit{Rate: 1, Period: time.Second}
+	for i := range memoryCleanupEvery - 1 {
+		_, _ = l.Allow(context.Background(), string(rune('a'+i%26))+time.Duration(i).String(), limit)
+	}
+
+	now = now.Add(time.Second)
+	_, _ = l.Allow(context.Background(), "last", limit)
+	if n := len(l.tats); n != 1 {
+		t.Fatalf("清理后剩余%d个key 期望1", n)
+	}
+}
+
+type failingLimiter struct{ err error }
+
+func (f failingLimiter) Allow(context.Context, string, Limit) (Result, error) {
+	return Result{}, f.err
+}
+
+func TestFallbackLimiterUsesMemory(t *testing.T) {
+	now := time.Now()
+	l := &fallbackLimiter{primary: failingLimiter{err: errors.New("redis down")}, fallback: newTestLimiter(&now)}
+	limit := Limit{Rate: 1, Period: time.Minute}
+
+	res, err := l.Allow(context.Background(), "k", limit)
+	if err != nil || !res.Allowed {
+		t.Fatalf("应由内存限流放行: %+v %v", res, err)
+	}
+	res, err = l.Allow(context.Background(), "k", limit)
+	if err != nil || res.Allowed {
+		t.Fatalf("内存限流应继续计数: %+v %v", res, err)
+	}
+	if !l.failing.Load() {
+		t.Fatal("应标记主限流器不可用")
+	}
+}
@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+// 响应头 参考 draft-ietf-httpapi-ratelimit-headers
+const (
+	HeaderLimit      = "RateLimit-Limit"
+	HeaderRemaining  = "RateLimit-Remaining"
+	HeaderReset      = "RateLimit-Reset"
+	HeaderPolicy     = "RateLimit-Policy"
+	HeaderRetryAfter = "Retry-After"
+)
+
+// KeyFunc 计数的key 返回空字符串时不限制
+type KeyFunc func(c *gin.Context) string
+
+// LimitFunc 当前请求适用的限额
+type LimitFunc func(c *gin.Context) Limit
+
+// ByIP 按客户端IP计数 只采信SERVER_TRUSTED_PROXIES中代理转发的X-Forwarded-For
+func ByIP() KeyFunc {
+	return func(c *gin.Context) string {
+		return "ip:" + c.ClientIP()
+	}
+}
+
+// ByUser 按登录用户计数 需在认证中间件之后使用 未登录时按IP计数
+func ByUser() KeyFunc {
+	return func(c *gin.Context) string {
+		if user, ok := auth.UserFromContext(c.Request.Context()); ok {
+			return "user:" + user.ID
+		}
+		return "ip:" + c.ClientIP()
+	}
+}
+
+// ByRoute 在key前加上路由 使每个接口单独计数
+func ByRoute(key KeyFunc) KeyFunc {
+	return func(c *gin.Context) string {
+		k := key(c)
+		if k == "" {
+			return ""
+		}
+		return "route:" + c.Request.Method + " " + c.FullPath() + ":" + k
+	}
+}
+
+// Fixed 固定限额
+func Fixed(rate int, period time.Duration) LimitFunc {
+	return func(*gin.Context) Limit {
+		return Limit{Rate: rate, Period: period}
+	}
+}
+
+// ByPlan 登录用户按订阅计划限额 未登录时使用匿名限额
+func ByPlan() LimitFunc {
+	return func(c *gin.Context) Limit {
+		user, ok := auth.UserFromContext(c.Request.Context())
+		if !ok {
+			return AnonymousLimit()
+		}
+		plan, err := PlanOf(c.Request.Context(), user.ID)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Warn("获取订阅计划失败,按free限流", zap.Error(err))
+			plan = DefaultPlan
+		}
+		return PlanLimit(plan)
+	}
+}
+
+// Middleware 限流中间件 超限时返回429 限流器出错时放行
+func Middleware(key KeyFunc, limit LimitFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !current.Load().enabled {
+			c.Next()
+			return
+		}
+
+		k := key(c)
+		l := limit(c)
+		if k == "" || l.IsZero() {
+			c.Next()
+			return
+		}
+
+		res, err := GetLimiterInstance().Allow(c.Request.Context(), k, l)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Error("限流判断失败", zap.Error(err))
+			c.Next()
+			return
+		}
+
+		setHeaders(c, l, res)
+		if !res.Allowed {
+			c.Header(HeaderRetryAfter, seconds(res.RetryAfter))
+			response.Error(c, codes.ErrRateLimitExceeded)
+			return
+		}
+		c.Next()
+	}
+}
+
+// PerUser 登录后的接口 按用户及订阅计划限流
+func PerUser() gin.HandlerFunc {
+	return Middleware(ByUser(), ByPlan())
+}
+
+// PerIP 无需登录的接口 按IP限流
+func PerIP() gin.HandlerFunc {
+	return Middleware(ByIP(), func(*gin.Context) Limit { return AnonymousLimit() })
+}
+
+func setHeaders(c *gin.Context, l Limit, res Result) {
+	c.Header(HeaderLimit, strconv.Itoa(res.Limit))
+	c.Header(HeaderRemaining, strconv.Itoa(res.Remaining))
+	c.Header(HeaderReset, seconds(res.ResetAfter))
+	c.Header(HeaderPolicy, strconv.Itoa(l.Rate)+";w="+seconds(l.Period))
+}
+
+// seconds 向上取整到秒
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/common/config"
+)
+
+func TestPerIPIgnoresForwardedForFromUntrustedPeer(t *testing.T) {
+	if err := Init(config.RateLimitConfig{Enabled: true, Backend: BackendMemory, Period: time.Minute, Anonymous: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(nil); err != nil {
+		t.Fatal(err)
+	}
+	engine.GET("/ping", PerIP(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	codes := make([]int, 0, 2)
+	for _, xff := range []string{"203.0.113.1", "203.0.113.2"} {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.RemoteAddr = "198.51.100.7:1234"
+		req.Header.Set("X-Forwarded-For", xff)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+		if w.Header().Get(HeaderLimit) != "1" {
+			t.Fatalf("缺少限流响应头: %v", w.Header())
+		}
+	}
+
+	// 伪造X-Forwarded-For不能绕过按IP限流
+	if codes[0] != http.StatusOK || codes[1] != http.StatusTooManyRequests {
+		t.Fatalf("响应码=%v 期望[200 429]", codes)
+	}
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/orm"
+)
+
+const (
+	// 订阅计划变更后最长该时长内生效 避免每个请求都查询数据库
+	planCacheTTL = time.Minute
+	// 最多缓存的用户数 超出后淘汰最久未访问的用户
+	planCacheSize = 10000
+)
+
+var plans = newPlanCache(planCacheSize, planCacheTTL)
+
+// 查询用户当前生效的订阅计划 测试中替换
+var lookupPlan = func(ctx context.Context, userID string) (string, error) {
+	sub, err := orm.UserSubscriptions(
+		orm.UserSubscriptionWhere.UserID.EQ(userID),
+		orm.UserSubscriptionWhere.Status.EQ("active"),
+		qm.Expr(
+			orm.UserSubscriptionWhere.ExpiresAt.IsNull(),
+			qm.Or2(orm.UserSubscriptionWhere.ExpiresAt.GT(null.TimeFrom(time.Now()))),
+		),
+	).One(ctx, datastore.GetDBInstance())
+	switch {
+	case err == nil:
+		return sub.PlanType, nil
+	case errors.Is(err, sql.ErrNoRows):
+		return DefaultPlan, nil
+	default:
+		return "", errors.Wrap(err, "查询订阅计划失败")
+	}
+}
+
+// PlanOf 用户当前生效的订阅计划 无有效订阅时为free
+func PlanOf(ctx context.Context, userID string) (string, error) {
+	if plan, ok := plans.get(userID); ok {
+		return plan, nil
+	}
+
+	plan, err := lookupPlan(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	plans.set(userID, plan)
+	return plan, nil
+}
+
+type planEntry struct {
+	userID  string
+	plan    string
+	expires time.Time
+}
+
+// planCache 容量有限的LRU缓存 条目到期后失效
+type planCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+	now     func() time.Time
+}
+
+func newPlanCache(size int, ttl time.Duration) *planCache {
+	return &planCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+		now:     time.Now,
+	}
+}
+
+func (c *planCache) get(userID string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[userID]
+	if !ok {
+		return "", false
+	}
+	entry := elem.Value.(*planEntry)
+	if !c.now().Before(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.entries, userID)
+		return "", false
+	}
+	c.order.MoveToFront(elem)
+	return entry.plan, true
+}
+
+func (c *planCache) set(userID, plan string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := c.now().Add(c.ttl)
+	if elem, ok := c.entries[userID]; ok {
+		entry := elem.Value.(*planEntry)
+		entry.plan, entry.expires = plan, expires
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[userID] = c.order.PushFront(&planEntry{userID: userID, plan: plan, expires: expires})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*planEntry).userID)
+	}
+}
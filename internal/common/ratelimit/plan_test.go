@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func (c *planCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func TestPlanCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newPlanCache(2, time.Minute)
+	c.set("a", "free")
+	c.set("b", "pro")
+	c.get("a")
+	c.set("c", "enterprise")
+
+	if c.len() != 2 {
+		t.Fatalf("缓存条目数=%d 期望2", c.len())
+	}
+	if _, ok := c.get("b"); ok {
+		t.Fatal("最久未访问的条目应被淘汰")
+	}
+	if plan, ok := c.get("a"); !ok || plan != "free" {
+		t.Fatalf("get(a)=%q,%v", plan, ok)
+	}
+}
+
+func TestPlanCacheExpires(t *testing.T) {
+	now := time.Now()
+	c := newPlanCache(10, time.Minute)
+	c.now = func() time.Time { return now }
+	c.set("a", "pro")
+
+	now = now.Add(time.Minute)
+	if _, ok := c.get("a"); ok {
+		t.Fatal("过期条目不应命中")
+	}
+	if c.len() != 0 {
+		t.Fatal("过期条目应被移除")
+	}
+}
+
+func TestPlanOfUsesCache(t *testing.T) {
+	prevPlans, prevLookup := plans, lookupPlan
+	t.Cleanup(func() { plans, lookupPlan = prevPlans, prevLookup })
+
+	calls := 0
+	plans = newPlanCache(10, time.Minute)
+	lookupPlan = func(context.Context, string) (string, error) {
+		calls++
+		return "pro", nil
+	}
+
+	for range 3 {
+		plan, err := PlanOf(context.Background(), "u1")
+		if err != nil || plan != "pro" {
+			t.Fatalf("PlanOf=%q,%v", plan, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("查询了%d次 期望1次", calls)
+	}
+}
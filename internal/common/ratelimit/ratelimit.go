@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+)
+
+// 存储后端
+const (
+	BackendRedis  = "redis"
+	BackendMemory = "memory"
+)
+
+// DefaultPlan 未订阅或计划未配置限额时使用
+const DefaultPlan = "free"
+
+// Limit 每Period允许Rate次请求 允许一次性突发用完
+type Limit struct {
+	Rate   int
+	Period time.Duration
+}
+
+// IsZero 未设置限额 不做限制
+func (l Limit) IsZero() bool {
+	return l.Rate <= 0 || l.Period <= 0
+}
+
+// Result 单次判断的结果
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// 额度完全恢复所需时间
+	ResetAfter time.Duration
+	// 被拒绝时需等待的时间
+	RetryAfter time.Duration
+}
+
+// Limiter 按key计数 同一key的不同Limit互不影响时应使用不同key
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// settings 由配置解析 支持热加载
+type settings struct {
+	enabled   bool
+	anonymous Limit
+	plans     map[string]Limit
+}
+
+var (
+	instance Limiter
+	current  atomic.Pointer[settings]
+)
+
+// Init 按配置创建全局限流器 redis后端需先初始化datastore
+func Init(cfg config.RateLimitConfig) error {
+	s, err := parseSettings(cfg)
+	if err != nil {
+		return err
+	}
+
+	switch cfg.Backend {
+	case BackendRedis:
+		// Redis不可用时退化为单实例内存限流 避免限流故障导致接口不可用
+		instance = &fallbackLimiter{primary: NewRedisLimiter(datastore.GetRedisInstance()), fallback: NewMemoryLimiter()}
+	case BackendMemory:
+		instance = NewMemoryLimiter()
+	default:
+		return errors.Errorf("不支持的限流后端%s", cfg.Backend)
+	}
+	current.Store(s)
+	return nil
+}
+
+// SetConfig 热加载时替换限额配置 后端类型需重启生效
+func SetConfig(cfg config.RateLimitConfig) error {
+	s, err := parseSettings(cfg)
+	if err != nil {
+		return err
+	}
+	current.Store(s)
+	return nil
+}
+
+// GetLimiterInstance 获取全局限流器 需先调用Init
+func GetLimiterInstance() Limiter {
+	if instance == nil {
+		panic("ratelimit未初始化")
+	}
+	return instance
+}
+
+func parseSettings(cfg config.RateLimitConfig) (*settings, error) {
+	s := &settings{
+		enabled:   cfg.Enabled,
+		anonymous: Limit{Rate: cfg.Anonymous, Period: cfg.Period},
+		plans:     make(map[string]Limit, len(cfg.Plans)),
+	}
+	for _, item := range cfg.Plans {
+		plan, rate, ok := strings.Cut(item, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(rate))
+		if !ok || err != nil || n < 0 {
+			return nil, errors.Errorf("RATE_LIMIT_PLANS格式错误: %s", item)
+		}
+		s.plans[strings.TrimSpace(plan)] = Limit{Rate: n, Period: cfg.Period}
+	}
+	return s, nil
+}
+
+// PlanLimit 指定订阅计划的限额 未配置时使用free
+func PlanLimit(plan string) Limit {
+	s := current.Load()
+	if l, ok := s.plans[plan]; ok {
+		return l
+	}
+	return s.plans[DefaultPlan]
+}
+
+// AnonymousLimit 未登录请求的限额
+func AnonymousLimit() Limit {
+	return current.Load().anonymous
+}
+
+// fallbackLimiter 主限流器出错时使用备用限流器
+type fallbackLimiter struct {
+	primary  Limiter
+	fallback Limiter
+	failing  atomic.Bool
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	res, err := l.primary.Allow(ctx, key, limit)
+	if err == nil {
+		if l.failing.Swap(false) {
+			zap.L().Info("限流后端已恢复")
+		}
+		return res, nil
+	}
+	// 只在首次失败时记录 避免每个请求都打印
+	if !l.failing.Swap(true) {
+		zap.L().Warn("限流后端不可用,使用内存限流", zap.Error(err))
+	}
+	return l.fallback.Allow(ctx, key, limit)
+}
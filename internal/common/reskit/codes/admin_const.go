@@ -0,0 +1,9 @@
+package codes
+
+var adminCodes = RegisterModule("admin", 1300, 1399)
+
+// 管理接口相关错误
+var (
+	ErrAdminRequired   = adminCodes.New(1301, ErrorTypeForbidden, "需要管理员权限")
+	ErrLogLevelInvalid = adminCodes.New(1302, ErrorTypeValidation, "无效的日志级别")
+)
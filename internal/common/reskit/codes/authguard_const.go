@@ -0,0 +1,10 @@
+package codes
+
+var authGuardCodes = RegisterModule("authguard", 1500, 1599)
+
+// 登录防护相关错误
+var (
+	ErrAuthLocked      = authGuardCodes.New(1501, ErrorTypeRateLimit, "失败次数过多,请稍后再试")
+	ErrCaptchaRequired = authGuardCodes.New(1502, ErrorTypeValidation, "请完成人机验证")
+	ErrCaptchaInvalid  = authGuardCodes.New(1503, ErrorTypeValidation, "人机验证失败")
+)
@@ -0,0 +1,110 @@
+package codes
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// 错误信息支持的语言
+const (
+	LangZH = "zh"
+	LangEN = "en"
+
+	DefaultLang = LangZH
+)
+
+var (
+	catalogMu sync.RWMutex
+	// 语言 -> 错误码 -> 信息模板
+	catalog = map[string]map[int]string{}
+)
+
+func init() {
+	RegisterMessages(LangZH, messagesZH)
+	RegisterMessages(LangEN, messagesEN)
+}
+
+// RegisterMessages 注册指定语言的错误信息 已存在的错误码会被覆盖
+// 信息中的{name}在输出时替换为错误详情中同名字段的值
+func RegisterMessages(lang string, messages map[int]string) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+
+	m, ok := catalog[lang]
+	if !ok {
+		m = make(map[int]string, len(messages))
+		catalog[lang] = m
+	}
+	for code, msg := range messages {
+		m[code] = msg
+	}
+}
+
+// Languages 已注册的语言
+func Languages() []string {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	langs := make([]string, 0, len(catalog))
+	for lang := range catalog {
+		langs = append(langs, lang)
+	}
+	return langs
+}
+
+// Localize 按语言获取错误信息 未注册的语言使用默认语言 均未找到时返回fallback
+// fallback中通过WithSlug追加的上下文会保留在翻译后的信息末尾
+func Localize(code int, lang string, params map[string]any, fallback string) string {
+	catalogMu.RLock()
+	msg, ok := catalog[lang][code]
+	if !ok {
+		msg, ok = catalog[DefaultLang][code]
+	}
+	catalogMu.RUnlock()
+
+	if !ok {
+		return fallback
+	}
+	msg = format(msg, params)
+	if slug := slugOf(code, fallback); slug != "" {
+		msg += " " + slug
+	}
+	return msg
+}
+
+// slugOf 取出信息中注册时的原始信息之后的部分
+func slugOf(code int, msg string) string {
+	r := defaultRegistry
+	r.mu.Lock()
+	e, ok := r.entries[code]
+	r.mu.Unlock()
+
+	if !ok {
+		return ""
+	}
+	slug, found := strings.CutPrefix(msg, e.Msg+" ")
+	if !found {
+		return ""
+	}
+	return slug
+}
+
+func hasMessage(lang string, code int) bool {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	_, ok := catalog[lang][code]
+	return ok
+}
+
+// format 替换信息模板中的{name}
+func format(msg string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(msg, "{") {
+		return msg
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", fmt.Sprint(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
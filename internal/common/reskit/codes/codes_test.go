@@ -0,0 +1,115 @@
+package codes
+
+import (
+	"strings"
+	"testing"
+)
+
+// 新增错误码时遗漏翻译或与其他模块区间冲突会导致启动失败 在CI中提前发现
+func TestValidate(t *testing.T) {
+	if err := Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name string
+		err  ErrCode
+		lang string
+		want string
+	}{
+		{name: "翻译", err: ErrGitHubAPIError, lang: LangEN, want: "GitHub API request failed"},
+		{name: "默认语言", err: ErrGitHubAPIError, lang: LangZH, want: "GitHub API调用失败"},
+		{name: "未注册语言回退默认语言", err: ErrGitHubAPIError, lang: "fr", want: "GitHub API调用失败"},
+		{name: "保留slug", err: ErrGitHubAPIError.WithSlug("get_access_token 获取失败"), lang: LangEN, want: "GitHub API request failed get_access_token 获取失败"},
+		{name: "保留多个slug", err: ErrUnauthorized.WithSlug("token").WithSlug("为空"), lang: LangEN, want: "Unauthorized token 为空"},
+		{name: "未注册的错误码返回原信息", err: ErrCode{Code: -1, Msg: "unknown"}, lang: LangEN, want: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Localize(tt.err.Code, tt.lang, nil, tt.err.Msg); got != tt.want {
+				t.Fatalf("Localize()=%q 期望%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(r *registry)
+		want     []string
+	}{
+		{
+			name: "无问题",
+			register: func(r *registry) {
+				m := r.registerModule("user", 1000, 1099)
+				m.New(1001, ErrorTypeUnauthorized, "未授权访问")
+				m.New(1031, ErrorTypeExternal, "GitHub API调用失败")
+				r.registerModule("other", 1100, 1199)
+			},
+		},
+		{
+			name: "错误码重复",
+			register: func(r *registry) {
+				m := r.registerModule("user", 1000, 1099)
+				m.New(1001, ErrorTypeUnauthorized, "未授权访问")
+				m.New(1001, ErrorTypeInternal, "重复")
+			},
+			want: []string{"错误码1001重复"},
+		},
+		{
+			name: "跨模块错误码重复",
+			register: func(r *registry) {
+				r.registerModule("user", 1000, 1099).New(1001, ErrorTypeUnauthorized, "未授权访问")
+				r.registerModule("other", 1100, 1199).New(1001, ErrorTypeInternal, "重复")
+			},
+			want: []string{"错误码1001重复", "超出模块other的区间"},
+		},
+		{
+			name: "区间重叠",
+			register: func(r *registry) {
+				r.registerModule("user", 1000, 1099)
+				r.registerModule("other", 1050, 1199)
+			},
+			want: []string{"模块other的错误码区间[1050,1199]与模块user的[1000,1099]重叠"},
+		},
+		{
+			name: "超出区间",
+			register: func(r *registry) {
+				r.registerModule("user", 1000, 1009).New(1031, ErrorTypeExternal, "GitHub API调用失败")
+			},
+			want: []string{"错误码1031超出模块user的区间[1000,1009]"},
+		},
+		{
+			name: "缺少翻译",
+			register: func(r *registry) {
+				r.registerModule("test", 900000, 900099).New(900001, ErrorTypeInternal, "未翻译")
+			},
+			want: []string{"错误码900001缺少zh翻译", "错误码900001缺少en翻译"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRegistry()
+			tt.register(r)
+			err := r.validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("validate() 期望返回错误")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("错误 %q 未包含 %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package codes
+
+var commonCodes = RegisterModule("common", 2000, 5999)
+
+// 通用错误 不属于具体模块
+var (
+	ErrValidationFailed = commonCodes.New(4000, ErrorTypeValidation, "参数校验失败")
+	ErrInternal         = commonCodes.New(5000, ErrorTypeInternal, "服务器内部错误")
+	ErrRequestTimeout   = commonCodes.New(5040, ErrorTypeTimeout, "请求超时")
+)
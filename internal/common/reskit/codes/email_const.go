@@ -0,0 +1,10 @@
+package codes
+
+var emailCodes = RegisterModule("email", 1200, 1299)
+
+// 邮件相关错误
+var (
+	ErrEmailDeliveryNotFound    = emailCodes.New(1201, ErrorTypeNotFound, "邮件投递记录不存在")
+	ErrEmailEventTypeInvalid    = emailCodes.New(1202, ErrorTypeValidation, "不支持的邮件事件类型")
+	ErrEmailWebhookUnauthorized = emailCodes.New(1203, ErrorTypeUnauthorized, "邮件回调签名无效")
+)
@@ -0,0 +1,117 @@
+package codes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCErrorDomain ErrorInfo中的domain 客户端据此识别业务错误码
+const GRPCErrorDomain = "sass-scaffold"
+
+// GRPCError gRPC错误信息 错误码与详情沿用HTTP错误响应
+type GRPCError struct {
+	StatusCode grpccodes.Code
+	Response   HTTPErrorResponse
+	Cause      error
+}
+
+// 令牌错误的类型为INTERNAL HTTP保持原状 gRPC客户端需要按未认证处理
+var grpcCodeOverrides = map[int]grpccodes.Code{
+	ErrTokenInvalid.Code: grpccodes.Unauthenticated,
+	ErrTokenExpired.Code: grpccodes.Unauthenticated,
+}
+
+// MapToGRPC 将领域错误映射为gRPC错误
+func MapToGRPC(err error) GRPCError {
+	httpErr := MapToHTTP(err)
+	code, ok := grpcCodeOverrides[httpErr.Response.Code]
+	if !ok {
+		code = mapTypeToGRPCCode(typeOf(err))
+	}
+	return GRPCError{
+		StatusCode: code,
+		Response:   httpErr.Response,
+		Cause:      httpErr.Cause,
+	}
+}
+
+// Status 转换为gRPC状态 业务错误码放入ErrorInfo.reason 详情放入metadata
+func (e GRPCError) Status() *status.Status {
+	st := status.New(e.StatusCode, e.Response.Message)
+
+	metadata := make(map[string]string, len(e.Response.Details)+1)
+	for k, v := range e.Response.Details {
+		metadata[k] = fmt.Sprint(v)
+	}
+	if e.Response.RequestID != "" {
+		metadata["request_id"] = e.Response.RequestID
+	}
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   strconv.Itoa(e.Response.Code),
+		Domain:   GRPCErrorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// GRPCCode 错误码对应的gRPC状态码
+func (e ErrCode) GRPCCode() grpccodes.Code {
+	if code, ok := grpcCodeOverrides[e.Code]; ok {
+		return code
+	}
+	return mapTypeToGRPCCode(e.Type)
+}
+
+// typeOf 获取错误类型 非自定义错误按超时或内部错误处理
+func typeOf(err error) ErrorType {
+	var errCode ErrCode
+	var errCode2 ErrCodeWithDetail
+	var errCode3 ErrCodeWithCause
+
+	switch {
+	case errors.As(err, &errCode):
+		return errCode.Type
+	case errors.As(err, &errCode2):
+		return errCode2.Type
+	case errors.As(err, &errCode3):
+		return errCode3.Type
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorTypeTimeout
+	default:
+		return ErrorTypeInternal
+	}
+}
+
+// mapTypeToGRPCCode 映射错误类型到gRPC状态码
+func mapTypeToGRPCCode(errorType ErrorType) grpccodes.Code {
+	switch errorType {
+	case ErrorTypeValidation:
+		return grpccodes.InvalidArgument
+	case ErrorTypeNotFound:
+		return grpccodes.NotFound
+	case ErrorTypeAlreadyExists:
+		return grpccodes.AlreadyExists
+	case ErrorTypeUnauthorized:
+		return grpccodes.Unauthenticated
+	case ErrorTypeForbidden:
+		return grpccodes.PermissionDenied
+	case ErrorTypeRateLimit:
+		return grpccodes.ResourceExhausted
+	case ErrorTypeExternal:
+		return grpccodes.Unavailable
+	case ErrorTypeTimeout:
+		return grpccodes.DeadlineExceeded
+	default: // ErrorTypeInternal
+		return grpccodes.Internal
+	}
+}
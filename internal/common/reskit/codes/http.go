@@ -1,6 +1,7 @@
 package codes
 
 import (
+	"context"
 	"errors"
 	"net/http"
 )
@@ -10,6 +11,8 @@ type HTTPErrorResponse struct {
 	Code    int                    `json:"code"`
 	Message string                 `json:"message"`
 	Details map[string]interface{} `json:"details,omitempty"`
+	// 请求ID 便于用户反馈问题时定位日志
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // HTTPError HTTP错误信息
@@ -39,13 +42,25 @@ func MapToHTTP(err error) HTTPError {
 	ok2 := errors.As(err, &errCode2)
 	ok3 := errors.As(err, &errCode3)
 
+	// 请求超过服务端超时时间 数据库等操作被取消
+	if !ok1 && !ok2 && !ok3 && errors.Is(err, context.DeadlineExceeded) {
+		return HTTPError{
+			StatusCode: ErrRequestTimeout.HTTPStatus(),
+			Response: HTTPErrorResponse{
+				Code:    ErrRequestTimeout.Code,
+				Message: ErrRequestTimeout.Msg,
+			},
+			Cause: err,
+		}
+	}
+
 	if !ok1 && !ok2 && !ok3 {
 		// 不是自定义错误，返回通用服务器错误
 		return HTTPError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: ErrInternal.HTTPStatus(),
 			Response: HTTPErrorResponse{
-				Code:    5000,
-				Message: "Internal server error",
+				Code:    ErrInternal.Code,
+				Message: ErrInternal.Msg,
 			},
 		}
 	}
@@ -65,7 +80,7 @@ func MapToHTTP(err error) HTTPError {
 			Response: HTTPErrorResponse{
 				Code:    errCode2.Code,
 				Message: errCode2.Msg,
-				Details: errCode3.Detail,
+				Details: errCode2.Detail,
 			},
 		}
 	}
@@ -81,6 +96,11 @@ func MapToHTTP(err error) HTTPError {
 	}
 }
 
+// HTTPStatus 错误码对应的HTTP状态码
+func (e ErrCode) HTTPStatus() int {
+	return mapTypeToHTTPStatus(e.Type)
+}
+
 // mapTypeToHTTPStatus 映射错误类型到HTTP状态码
 func mapTypeToHTTPStatus(errorType ErrorType) int {
 	switch errorType {
@@ -98,6 +118,8 @@ func mapTypeToHTTPStatus(errorType ErrorType) int {
 		return http.StatusTooManyRequests
 	case ErrorTypeExternal:
 		return http.StatusBadGateway
+	case ErrorTypeTimeout:
+		return http.StatusGatewayTimeout
 	default: // ErrorTypeInternal
 		return http.StatusInternalServerError
 	}
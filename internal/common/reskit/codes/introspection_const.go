@@ -0,0 +1,8 @@
+package codes
+
+var introspectionCodes = RegisterModule("introspection", 1600, 1699)
+
+// 令牌内省相关错误
+var (
+	ErrIntrospectionUnauthorized = introspectionCodes.New(1601, ErrorTypeUnauthorized, "调用方认证失败")
+)
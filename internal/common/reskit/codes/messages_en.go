@@ -0,0 +1,57 @@
+package codes
+
+// 英文错误信息
+var messagesEN = map[int]string{
+	4000: "Validation failed",
+	5000: "Internal server error",
+	5040: "Request timeout",
+
+	// 用户
+	1001: "Unauthorized",
+	1002: "User not found",
+	1003: "User already exists",
+	1004: "Email is already in use",
+	1005: "Username is already in use",
+	1011: "Invalid OAuth authorization code",
+	1012: "Unsupported OAuth provider",
+	1013: "OAuth user info is missing",
+	1021: "Failed to generate token",
+	1022: "Invalid token",
+	1023: "Token has expired",
+	1024: "Invalid refresh token",
+	1025: "Refresh token has expired",
+	1026: "Token is missing the required scope: {scope}",
+	1031: "GitHub API request failed",
+	1032: "Google API request failed",
+	1041: "Team not found",
+	1042: "You are not allowed to manage this team",
+	1043: "Team name is already in use",
+	1044: "Team member not found",
+	1045: "The user is already a member of this team",
+	1046: "The team owner cannot be changed",
+
+	// Webhook
+	1101: "Webhook not found",
+	1102: "Unsupported webhook event type",
+	1103: "Webhook delivery not found",
+
+	// 邮件
+	1201: "Email delivery not found",
+	1202: "Unsupported email event type",
+	1203: "Invalid email callback signature",
+
+	// 管理接口
+	1301: "Administrator privileges required",
+	1302: "Invalid log level: {level}",
+
+	// 限流
+	1401: "Too many requests, please try again later",
+
+	// 登录防护
+	1501: "Too many failed attempts, please try again in {retry_after} seconds",
+	1502: "Please complete the CAPTCHA",
+	1503: "CAPTCHA verification failed",
+
+	// 令牌内省
+	1601: "Client authentication failed",
+}
@@ -0,0 +1,57 @@
+package codes
+
+// 中文错误信息 新增错误码时同步补充 缺少翻译时codes.Validate返回错误
+var messagesZH = map[int]string{
+	4000: "参数校验失败",
+	5000: "服务器内部错误",
+	5040: "请求超时",
+
+	// 用户
+	1001: "未授权访问",
+	1002: "用户不存在",
+	1003: "用户已存在",
+	1004: "邮箱已被使用",
+	1005: "用户名已被使用",
+	1011: "无效的OAuth授权码",
+	1012: "不支持的OAuth提供商",
+	1013: "OAuth用户信息缺失",
+	1021: "Token生成失败",
+	1022: "Token无效",
+	1023: "Token已过期",
+	1024: "无效的RefreshToken",
+	1025: "RefreshToken已过期",
+	1026: "令牌权限不足,缺少{scope}",
+	1031: "GitHub API调用失败",
+	1032: "Google API调用失败",
+	1041: "团队不存在",
+	1042: "无权管理该团队",
+	1043: "团队名称已被使用",
+	1044: "团队成员不存在",
+	1045: "该用户已是团队成员",
+	1046: "不能修改团队所有者",
+
+	// Webhook
+	1101: "Webhook不存在",
+	1102: "不支持的Webhook事件类型",
+	1103: "Webhook投递记录不存在",
+
+	// 邮件
+	1201: "邮件投递记录不存在",
+	1202: "不支持的邮件事件类型",
+	1203: "邮件回调签名无效",
+
+	// 管理接口
+	1301: "需要管理员权限",
+	1302: "无效的日志级别: {level}",
+
+	// 限流
+	1401: "请求过于频繁,请稍后再试",
+
+	// 登录防护
+	1501: "失败次数过多,请{retry_after}秒后再试",
+	1502: "请完成人机验证",
+	1503: "人机验证失败",
+
+	// 令牌内省
+	1601: "调用方认证失败",
+}
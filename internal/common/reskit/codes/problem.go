@@ -0,0 +1,39 @@
+package codes
+
+import (
+	"strconv"
+)
+
+// ProblemContentType RFC 7807 错误响应的媒体类型
+const ProblemContentType = "application/problem+json"
+
+// Problem RFC 7807 Problem Details code与details为扩展字段
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	Code          int                    `json:"code"`
+	Details       map[string]interface{} `json:"details,omitempty"`
+	InvalidParams []InvalidParam         `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam 校验失败的参数
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Problem 转换为RFC 7807格式 type为typeBase加错误码 instance为请求ID
+func (e HTTPError) Problem(typeBase string) Problem {
+	return Problem{
+		Type:     typeBase + strconv.Itoa(e.Response.Code),
+		Title:    e.Response.Message,
+		Status:   e.StatusCode,
+		Instance: e.Response.RequestID,
+		Code:     e.Response.Code,
+		Details:  e.Response.Details,
+	}
+}
@@ -0,0 +1,8 @@
+package codes
+
+var rateLimitCodes = RegisterModule("ratelimit", 1400, 1499)
+
+// 限流相关错误
+var (
+	ErrRateLimitExceeded = rateLimitCodes.New(1401, ErrorTypeRateLimit, "请求过于频繁,请稍后再试")
+)
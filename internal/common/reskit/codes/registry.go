@@ -0,0 +1,128 @@
+package codes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Module 一个模块占用的错误码区间 [Min, Max]
+type Module struct {
+	Name string
+	Min  int
+	Max  int
+
+	reg *registry
+}
+
+// Entry 已注册的错误码
+type Entry struct {
+	Module string
+	ErrCode
+}
+
+// registry 错误码注册表 包级函数使用全局实例 测试可单独创建
+type registry struct {
+	mu      sync.Mutex
+	modules []*Module
+	entries map[int]Entry
+	// 注册时发现的问题 由Validate统一返回 避免在包初始化阶段panic
+	problems []string
+}
+
+var defaultRegistry = newRegistry()
+
+func newRegistry() *registry {
+	return &registry{entries: map[int]Entry{}}
+}
+
+// RegisterModule 声明模块的错误码区间 区间重叠时Validate返回错误
+func RegisterModule(name string, min, max int) *Module {
+	return defaultRegistry.registerModule(name, min, max)
+}
+
+func (r *registry) registerModule(name string, min, max int) *Module {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := &Module{Name: name, Min: min, Max: max, reg: r}
+	for _, other := range r.modules {
+		if m.Min <= other.Max && other.Min <= m.Max {
+			r.problems = append(r.problems, fmt.Sprintf("模块%s的错误码区间[%d,%d]与模块%s的[%d,%d]重叠",
+				m.Name, m.Min, m.Max, other.Name, other.Min, other.Max))
+		}
+	}
+	r.modules = append(r.modules, m)
+	return m
+}
+
+// New 在模块区间内注册错误码 错误码重复或超出区间时Validate返回错误
+func (m *Module) New(code int, typ ErrorType, msg string) ErrCode {
+	r := m.reg
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := ErrCode{Msg: msg, Type: typ, Code: code}
+	if code < m.Min || code > m.Max {
+		r.problems = append(r.problems, fmt.Sprintf("错误码%d超出模块%s的区间[%d,%d]", code, m.Name, m.Min, m.Max))
+	}
+	if old, ok := r.entries[code]; ok {
+		r.problems = append(r.problems, fmt.Sprintf("错误码%d重复: %s(%s) 与 %s(%s)", code, old.Msg, old.Module, msg, m.Name))
+		return e
+	}
+	r.entries[code] = Entry{Module: m.Name, ErrCode: e}
+	return e
+}
+
+// Validate 检查错误码区间重叠、错误码重复或越界以及缺少翻译 应在启动时调用
+func Validate() error {
+	return defaultRegistry.validate()
+}
+
+func (r *registry) validate() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	found := append([]string(nil), r.problems...)
+	for _, lang := range Languages() {
+		for code := range r.entries {
+			if !hasMessage(lang, code) {
+				found = append(found, fmt.Sprintf("错误码%d缺少%s翻译", code, lang))
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	sort.Strings(found)
+	return fmt.Errorf("错误码注册有误:\n%s", strings.Join(found, "\n"))
+}
+
+// Modules 已声明的模块 按区间排序
+func Modules() []Module {
+	r := defaultRegistry
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]Module, 0, len(r.modules))
+	for _, m := range r.modules {
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Min < out[j].Min })
+	return out
+}
+
+// Entries 已注册的错误码 按错误码排序
+func Entries() []Entry {
+	r := defaultRegistry
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]Entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Code < out[j].Code })
+	return out
+}
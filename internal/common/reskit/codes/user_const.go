@@ -1,26 +1,37 @@
 package codes
 
+var userCodes = RegisterModule("user", 1000, 1099)
+
 // 用户相关错误
 var (
-	ErrUnauthorized          = ErrCode{Msg: "未授权访问", Type: ErrorTypeUnauthorized, Code: 1001}
-	ErrUserNotFound          = ErrCode{Msg: "用户不存在", Type: ErrorTypeNotFound, Code: 1002}
-	ErrUserAlreadyExists     = ErrCode{Msg: "用户已存在", Type: ErrorTypeAlreadyExists, Code: 1003}
-	ErrEmailAlreadyExists    = ErrCode{Msg: "邮箱已被使用", Type: ErrorTypeAlreadyExists, Code: 1004}
-	ErrUsernameAlreadyExists = ErrCode{Msg: "用户名已被使用", Type: ErrorTypeAlreadyExists, Code: 1005}
+	ErrUnauthorized          = userCodes.New(1001, ErrorTypeUnauthorized, "未授权访问")
+	ErrUserNotFound          = userCodes.New(1002, ErrorTypeNotFound, "用户不存在")
+	ErrUserAlreadyExists     = userCodes.New(1003, ErrorTypeAlreadyExists, "用户已存在")
+	ErrEmailAlreadyExists    = userCodes.New(1004, ErrorTypeAlreadyExists, "邮箱已被使用")
+	ErrUsernameAlreadyExists = userCodes.New(1005, ErrorTypeAlreadyExists, "用户名已被使用")
 
 	// OAuth相关错误
-	ErrOAuthInvalidCode     = ErrCode{Msg: "无效的OAuth授权码", Type: ErrorTypeValidation, Code: 1011}
-	ErrOAuthInvalidProvider = ErrCode{Msg: "不支持的OAuth提供商", Type: ErrorTypeValidation, Code: 1012}
-	ErrOAuthUserInfoMissing = ErrCode{Msg: "OAuth用户信息缺失", Type: ErrorTypeValidation, Code: 1013}
+	ErrOAuthInvalidCode     = userCodes.New(1011, ErrorTypeValidation, "无效的OAuth授权码")
+	ErrOAuthInvalidProvider = userCodes.New(1012, ErrorTypeValidation, "不支持的OAuth提供商")
+	ErrOAuthUserInfoMissing = userCodes.New(1013, ErrorTypeValidation, "OAuth用户信息缺失")
 
 	// Token相关错误
-	ErrTokenGenerationFailed = ErrCode{Msg: "Token生成失败", Type: ErrorTypeInternal, Code: 1021}
-	ErrTokenInvalid          = ErrCode{Msg: "Token无效", Type: ErrorTypeInternal, Code: 1022}
-	ErrTokenExpired          = ErrCode{Msg: "Token已过期", Type: ErrorTypeInternal, Code: 1023}
-	ErrRefreshTokenInvalid   = ErrCode{Msg: "无效的RefreshToken", Type: ErrorTypeUnauthorized, Code: 1024}
-	ErrRefreshTokenExpired   = ErrCode{Msg: "RefreshToken已过期", Type: ErrorTypeUnauthorized, Code: 1025}
+	ErrTokenGenerationFailed = userCodes.New(1021, ErrorTypeInternal, "Token生成失败")
+	ErrTokenInvalid          = userCodes.New(1022, ErrorTypeInternal, "Token无效")
+	ErrTokenExpired          = userCodes.New(1023, ErrorTypeInternal, "Token已过期")
+	ErrRefreshTokenInvalid   = userCodes.New(1024, ErrorTypeUnauthorized, "无效的RefreshToken")
+	ErrRefreshTokenExpired   = userCodes.New(1025, ErrorTypeUnauthorized, "RefreshToken已过期")
+	ErrInsufficientScope     = userCodes.New(1026, ErrorTypeForbidden, "令牌权限不足")
+
+	// 团队相关错误
+	ErrTeamNotFound         = userCodes.New(1041, ErrorTypeNotFound, "团队不存在")
+	ErrTeamPermissionDenied = userCodes.New(1042, ErrorTypeForbidden, "无权管理该团队")
+	ErrTeamAlreadyExists    = userCodes.New(1043, ErrorTypeAlreadyExists, "团队名称已被使用")
+	ErrTeamMemberNotFound   = userCodes.New(1044, ErrorTypeNotFound, "团队成员不存在")
+	ErrTeamMemberExists     = userCodes.New(1045, ErrorTypeAlreadyExists, "该用户已是团队成员")
+	ErrTeamOwnerImmutable   = userCodes.New(1046, ErrorTypeForbidden, "不能修改团队所有者")
 
 	// 外部服务错误
-	ErrGitHubAPIError = ErrCode{Msg: "GitHub API调用失败", Type: ErrorTypeExternal, Code: 1031}
-	ErrGoogleAPIError = ErrCode{Msg: "Google API调用失败", Type: ErrorTypeExternal, Code: 1032}
+	ErrGitHubAPIError = userCodes.New(1031, ErrorTypeExternal, "GitHub API调用失败")
+	ErrGoogleAPIError = userCodes.New(1032, ErrorTypeExternal, "Google API调用失败")
 )
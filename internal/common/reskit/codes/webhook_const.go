@@ -0,0 +1,10 @@
+package codes
+
+var webhookCodes = RegisterModule("webhook", 1100, 1199)
+
+// Webhook相关错误
+var (
+	ErrWebhookNotFound         = webhookCodes.New(1101, ErrorTypeNotFound, "Webhook不存在")
+	ErrWebhookEventTypeInvalid = webhookCodes.New(1102, ErrorTypeValidation, "不支持的Webhook事件类型")
+	ErrWebhookDeliveryNotFound = webhookCodes.New(1103, ErrorTypeNotFound, "Webhook投递记录不存在")
+)
@@ -3,7 +3,10 @@ package response
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/middleware/requestid"
 	"sass-scaffold/internal/common/reskit/codes"
 
 	"sass-scaffold/internal/common/validator/i18n"
@@ -38,16 +41,27 @@ func Error(c *gin.Context, err error) {
 
 	// 映射错误
 	httpErr := codes.MapToHTTP(err)
+	httpErr.Response.RequestID = requestid.FromContext(c.Request.Context())
 
 	// 将需要日志记录的错误到Gin的错误列表 让后续中间件去记录
 	if httpErr.Cause != nil {
 		msg := httpErr.Response.Message
 		if httpErr.Response.Details != nil {
-			msg = fmt.Sprintf("%s | details: %v", msg, httpErr.Response.Details)
+			// 详情可能包含授权码、邮箱等 记录日志前脱敏
+			msg = fmt.Sprintf("%s | details: %v", msg, logger.RedactValue(httpErr.Response.Details))
 		}
 		_ = c.Error(errors.WithMessage(httpErr.Cause, msg))
 	}
 
+	// 日志保留原始信息 响应按请求语言输出
+	lang := requestLang(c)
+	httpErr.Response.Message = codes.Localize(httpErr.Response.Code, lang, httpErr.Response.Details, httpErr.Response.Message)
+	c.Header("Content-Language", lang)
+
+	if wantsProblem(c) {
+		abortWithProblem(c, httpErr, nil, "")
+		return
+	}
 	c.AbortWithStatusJSON(httpErr.StatusCode, httpErr.Response)
 }
 
@@ -62,13 +76,37 @@ func ValidationError(c *gin.Context, err error) {
 	_ = c.Error(err)
 
 	// 翻译验证错误
-	validationErrors := i18n.TranslateError(err)
+	lang := requestLang(c)
+	validationErrors := i18n.TranslateError(err, lang)
+	c.Header("Content-Language", lang)
 
-	c.AbortWithStatusJSON(400, codes.HTTPErrorResponse{
-		Code:		4000,
-		Message:	"Validation failed",
-		Details: map[string]interface{}{
-			"errors": validationErrors,
+	httpErr := codes.HTTPError{
+		StatusCode:	codes.ErrValidationFailed.HTTPStatus(),
+		Response: codes.HTTPErrorResponse{
+			Code:		codes.ErrValidationFailed.Code,
+			Message:	codes.Localize(codes.ErrValidationFailed.Code, lang, nil, codes.ErrValidationFailed.Msg),
+			Details: map[string]interface{}{
+				"errors": validationErrors,
+			},
+			RequestID:	requestid.FromContext(c.Request.Context()),
 		},
-	})
+	}
+
+	if wantsProblem(c) {
+		// 请求体无法解析等非字段校验错误 不属于invalid-params
+		var fieldErrors validator.ValidationErrors
+		if !errors.As(err, &fieldErrors) {
+			httpErr.Response.Details = nil
+			abortWithProblem(c, httpErr, nil, err.Error())
+			return
+		}
+		abortWithProblem(c, httpErr, toInvalidParams(validationErrors), "")
+		return
+	}
+	c.AbortWithStatusJSON(httpErr.StatusCode, httpErr.Response)
+}
+
+// requestLang 按Accept-Language从已注册的错误信息语言中选择
+func requestLang(c *gin.Context) string {
+	return i18n.ParseAcceptLanguage(c.GetHeader("Accept-Language"), codes.Languages()...)
 }
@@ -0,0 +1,79 @@
+package response
+
+import (
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/validator/i18n"
+)
+
+const defaultProblemTypeBase = "/errors/"
+
+// wantsProblem 配置开启或客户端在Accept中声明时使用problem+json
+func wantsProblem(c *gin.Context) bool {
+	if cfg := config.GetConfigInstance(); cfg != nil && cfg.Server.ProblemJSON {
+		return true
+	}
+	for _, accept := range strings.Split(c.GetHeader("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept)); err == nil && mediaType == codes.ProblemContentType {
+			return true
+		}
+	}
+	return false
+}
+
+func problemTypeBase() string {
+	if cfg := config.GetConfigInstance(); cfg != nil && cfg.Server.ProblemTypeBase != "" {
+		return cfg.Server.ProblemTypeBase
+	}
+	return defaultProblemTypeBase
+}
+
+// abortWithProblem 以problem+json格式输出错误
+func abortWithProblem(c *gin.Context, httpErr codes.HTTPError, invalidParams []codes.InvalidParam, detail string) {
+	problem := httpErr.Problem(problemTypeBase())
+	problem.Detail = detail
+	if len(invalidParams) > 0 {
+		problem.InvalidParams = invalidParams
+		problem.Details = nil
+	}
+
+	c.Abort()
+	c.Render(httpErr.StatusCode, problemRender{problem})
+}
+
+// toInvalidParams 校验错误按字段名排序 保证输出稳定
+func toInvalidParams(errs i18n.ValidatorError) []codes.InvalidParam {
+	params := make([]codes.InvalidParam, 0, len(errs))
+	for name, reason := range errs {
+		params = append(params, codes.InvalidParam{Name: name, Reason: reason})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return params
+}
+
+// problemRender 与render.JSON相同 仅Content-Type不同
+type problemRender struct {
+	problem codes.Problem
+}
+
+func (r problemRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return render.JSON{Data: r.problem}.Render(w)
+}
+
+func (r problemRender) WriteContentType(w http.ResponseWriter) {
+	header := w.Header()
+	if val := header["Content-Type"]; len(val) == 0 {
+		header["Content-Type"] = []string{codes.ProblemContentType}
+	}
+}
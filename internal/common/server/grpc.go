@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/metrics"
+	"sass-scaffold/internal/common/middleware/requestid"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/validator/i18n"
+)
+
+// 创建gRPC服务器 内置拦截器先于opts中的拦截器执行
+func newGrpcServer(dev bool, metricsClient metrics.Client, register func(s *grpc.Server), opts ...grpc.ServerOption) (*grpc.Server, *grpchealth.Server) {
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(grpcRecovery(metricsClient), requestid.UnaryServerInterceptor(), grpcErrorHandler(metricsClient)),
+		grpc.ChainStreamInterceptor(grpcStreamRecovery(metricsClient)),
+	}
+	s := grpc.NewServer(append(options, opts...)...)
+
+	register(s)
+
+	// 标准健康检查 关闭时切换为NOT_SERVING
+	healthServer := grpchealth.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, healthServer)
+
+	// 开发环境开启反射 便于grpcurl调试
+	if dev {
+		reflection.Register(s)
+	}
+	return s, healthServer
+}
+
+type grpcServer struct {
+	server *grpc.Server
+	health *grpchealth.Server
+}
+
+// 记录日志与指标 并将领域错误转换为gRPC状态
+func grpcErrorHandler(metricsClient metrics.Client) grpc.UnaryServerInterceptor {
+	requests := metricsClient.Counter("grpc_requests_total", "Total number of gRPC requests", "method", "code")
+	durations := metricsClient.Histogram("grpc_request_duration_seconds", "gRPC request duration in seconds", metrics.DefaultBuckets, "method", "code")
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		cost := time.Since(start)
+
+		reqLogger := logger.FromContext(ctx).Named("grpc").With(
+			zap.String("method", info.FullMethod),
+			zap.String("cost", fmt.Sprintf("%dms", cost.Milliseconds())),
+		)
+
+		// 日志记录转换前的原始错误 处理函数已返回gRPC状态时原样透传
+		cause := err
+		if _, ok := status.FromError(err); err != nil && !ok {
+			grpcErr := codes.MapToGRPC(err)
+			grpcErr.Response.RequestID = requestid.FromContext(ctx)
+
+			lang := grpcLang(ctx)
+			grpcErr.Response.Message = codes.Localize(grpcErr.Response.Code, lang, grpcErr.Response.Details, grpcErr.Response.Message)
+			_ = grpc.SetHeader(ctx, metadata.Pairs("content-language", lang))
+
+			err = grpcErr.Status().Err()
+		}
+
+		code := status.Code(err)
+		requests.Inc(info.FullMethod, code.String())
+		durations.Observe(cost.Seconds(), info.FullMethod, code.String())
+
+		if err == nil {
+			reqLogger.Info("Request handled successfully")
+		} else {
+			reqLogger.Error("Request failed", zap.String("code", code.String()), zap.String("error", logger.Redact(cause.Error())))
+		}
+		return resp, err
+	}
+}
+
+// 按metadata中的accept-language选择错误信息语言
+func grpcLang(ctx context.Context) string {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("accept-language"); len(values) > 0 {
+			header = values[0]
+		}
+	}
+	return i18n.ParseAcceptLanguage(header, codes.Languages()...)
+}
+
+// panic恢复 返回内部错误
+func grpcRecovery(metricsClient metrics.Client) grpc.UnaryServerInterceptor {
+	panics := metricsClient.Counter("grpc_panics_total", "Total number of recovered panics in gRPC handlers", "method")
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				panics.Inc(info.FullMethod)
+				err = recovered(ctx, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func grpcStreamRecovery(metricsClient metrics.Client) grpc.StreamServerInterceptor {
+	panics := metricsClient.Counter("grpc_panics_total", "Total number of recovered panics in gRPC handlers", "method")
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				panics.Inc(info.FullMethod)
+				err = recovered(ss.Context(), info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// panic值可能包含请求数据 记录前脱敏
+func recovered(ctx context.Context, method string, p any) error {
+	logger.FromContext(ctx).Named("grpc").Error("Panic recovered",
+		zap.String("method", method),
+		zap.String("panic", logger.Redact(fmt.Sprint(p))),
+		zap.String("stack", logger.Redact(string(debug.Stack()))),
+	)
+	return codes.MapToGRPC(codes.ErrInternal).Status().Err()
+}
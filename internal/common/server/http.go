@@ -5,39 +5,49 @@ import (
 	"fmt"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/health"
 	"sass-scaffold/internal/common/metrics"
+	"sass-scaffold/internal/common/middleware/requestid"
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/ratelimit"
+	"sass-scaffold/internal/common/tracing"
 	"sass-scaffold/internal/common/validator"
-	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
 
-func RunHttpServer(port string, metricsClient metrics.Client, registerRouter func(r *gin.RouterGroup)) {
+// RunHttpServer 启动HTTP服务 配置了GrpcPort时同时启动gRPC服务 收到退出信号后一同优雅关闭
+// grpcOpts中的拦截器在内置的恢复、请求ID、错误转换拦截器之后执行
+func RunHttpServer(cfg config.ServerConfig, metricsClient metrics.Client, registerRouter func(r *gin.RouterGroup), registerGrpc func(s *grpc.Server), grpcOpts ...grpc.ServerOption) {
+	port := cfg.Port
 	if port == "" {
 		panic(errors.New("RunHttpServer中的port无效"))
 	}
 
-	_ = godotenv.Load()
-	mode := os.Getenv("SERVER_MODE")
-	if mode == "" {
-		panic("读取SERVER_MODE环境变量失败")
-	}
-
-	if mode == "dev" {
+	if cfg.IsDev() {
 		gin.SetMode(gin.DebugMode)
 	} else {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	engine := gin.Default()
+	// 不使用gin默认的Recovery 由recoveryHandler返回统一的错误格式
+	engine := gin.New()
+	// gin默认信任所有代理 任何客户端都可通过X-Forwarded-For伪造ClientIP
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		panic(errors.WithMessage(err, "SERVER_TRUSTED_PROXIES配置错误"))
+	}
+	engine.Use(gin.Logger())
 
-	engine.Use(errorHandler(), logHandler(), metricsHandler(metricsClient))
+	engine.Use(tracing.Middleware(), requestid.Middleware(), errorHandler(), logHandler(), metricsHandler(metricsClient), recoveryHandler(metricsClient), timeoutHandler(cfg.RequestTimeout))
 
 	// 注册验证器
 	if err := validator.Init(); err != nil {
@@ -45,7 +55,10 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 	}
 
 	// 配置CORS中间件
-	setCORS(engine)
+	setCORS(engine, cfg.AllowOrigins)
+
+	// 存活与就绪检查 不经过/api前缀 便于负载均衡与k8s探针访问
+	health.RegisterRoutes(engine)
 
 	// 配置404路由
 	engine.NoRoute(func(c *gin.Context) {
@@ -56,6 +69,9 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 
 	registerRouter(routerGroup)
 
+	// 按已注册的路由生成接口文档 需在业务路由之后注册
+	openapi.RegisterRoutes(engine, cfg.IsDev())
+
 	// 创建HTTP服务器
 	server := &http.Server{
 		Addr:		fmt.Sprintf(":%s", port),
@@ -71,20 +87,87 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 		}
 	}()
 
+	grpcServer := runGrpcServer(cfg, metricsClient, registerGrpc, grpcOpts...)
+
 	// 等待终止信号
 	sig := waitForSignal()
 	log.Printf("接收到信号:%v\n", sig.String())
 
+	// 先标记未就绪 等待负载均衡摘除实例后再关闭
+	health.SetShuttingDown()
+	if grpcServer != nil {
+		grpcServer.health.Shutdown()
+	}
+	if cfg.ShutdownDelay > 0 {
+		log.Printf("等待%v后关闭服务器...\n", cfg.ShutdownDelay)
+		time.Sleep(cfg.ShutdownDelay)
+	}
+
 	log.Println("正在关闭服务器...")
 
 	// 优雅关闭服务
+	if grpcServer != nil {
+		shutdownGrpcServer(grpcServer.server)
+	}
 	shutdownServer(server)
 }
 
+// 未配置端口或没有注册服务时不启动
+func runGrpcServer(cfg config.ServerConfig, metricsClient metrics.Client, register func(s *grpc.Server), opts ...grpc.ServerOption) *grpcServer {
+	if cfg.GrpcPort == "" || register == nil {
+		return nil
+	}
+
+	server, healthServer := newGrpcServer(cfg.IsDev(), metricsClient, register, opts...)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GrpcPort))
+	if err != nil {
+		panic(errors.WithMessage(err, "gRPC端口监听失败"))
+	}
+
+	go func() {
+		log.Printf("gRPC服务器启动,端口:%v\n", cfg.GrpcPort)
+
+		if err := server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Fatalf("gRPC服务器启动失败,err:%#v\n", err)
+		}
+	}()
+
+	return &grpcServer{server: server, health: healthServer}
+}
+
+// 等待进行中的调用完成 超时后强制关闭
+func shutdownGrpcServer(server *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("gRPC服务器已退出")
+	case <-time.After(5 * time.Second):
+		server.Stop()
+		log.Println("gRPC服务器关闭超时,已强制退出")
+	}
+}
+
+// 等待退出信号 SIGHUP只重新加载配置 不退出
 func waitForSignal() os.Signal {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
-	return <-quit
+	for sig := range quit {
+		if sig != syscall.SIGHUP {
+			return sig
+		}
+
+		log.Println("接收到SIGHUP,重新加载配置...")
+		if err := config.Reload(); err != nil {
+			log.Printf("配置重新加载失败,继续使用原配置,err:%v\n", err)
+		}
+	}
+	return nil
 }
 
 // 优雅关闭服务器
@@ -98,16 +181,32 @@ func shutdownServer(server *http.Server) {
 	log.Println("服务器已退出")
 }
 
-func setCORS(r *gin.Engine) {
+// 允许的跨域来源 支持热加载
+var allowOrigins atomic.Pointer[map[string]struct{}]
+
+// SetAllowOrigins 替换允许的跨域来源 热加载时由main调用
+func SetAllowOrigins(allows []string) {
+	m := make(map[string]struct{}, len(allows))
+	for _, origin := range allows {
+		m[origin] = struct{}{}
+	}
+	allowOrigins.Store(&m)
+}
+
+func setCORS(r *gin.Engine, allows []string) {
 	corsCfg := cors.DefaultConfig()
-	allowsStr := os.Getenv("SERVER_ALLOW_ORIGINS")
-	if allowsStr == "" {
-		panic(errors.New("httpserver加载SERVER_ALLOW_ORIGINS环境变量失败"))
+	if len(allows) == 0 {
+		panic(errors.New("httpserver的SERVER_ALLOW_ORIGINS配置为空"))
 	}
-	allows := strings.Split(allowsStr, ",")
 
-	corsCfg.AllowOrigins = allows
+	SetAllowOrigins(allows)
+
+	corsCfg.AllowOriginFunc = func(origin string) bool {
+		_, ok := (*allowOrigins.Load())[origin]
+		return ok
+	}
 	corsCfg.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
-	corsCfg.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "Refresh-Token"}
+	corsCfg.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "Refresh-Token", requestid.HeaderKey}
+	corsCfg.ExposeHeaders = []string{requestid.HeaderKey, ratelimit.HeaderLimit, ratelimit.HeaderRemaining, ratelimit.HeaderReset, ratelimit.HeaderPolicy, ratelimit.HeaderRetryAfter}
 	r.Use(cors.New(corsCfg))
 }
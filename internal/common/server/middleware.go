@@ -1,12 +1,21 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"log"
+	"net"
+	"os"
+	"runtime/debug"
+	"sass-scaffold/internal/common/logger"
+	"net/http"
 	"sass-scaffold/internal/common/metrics"
+	"sass-scaffold/internal/common/reskit/response"
 	"strings"
+	"syscall"
 	"time"
 )
 
@@ -29,7 +38,7 @@ func errorHandler() gin.HandlerFunc {
 
 func printBusinessStack(err error) {
 	// 获取完整错误栈
-	stackTrace := fmt.Sprintf("%+v", err)
+	stackTrace := logger.Redact(fmt.Sprintf("%+v", err))
 	lines := strings.Split(stackTrace, "\n")
 
 	// 错误消息
@@ -106,7 +115,7 @@ func logHandler() gin.HandlerFunc {
 			errMsg = ctx.Errors.String()
 		}
 
-		logger := zap.L().With(
+		reqLogger := logger.FromContext(ctx.Request.Context()).Named("http").With(
 			zap.String("ip", ctx.ClientIP()),
 			zap.String("method", method),
 			zap.String("path", path),
@@ -115,15 +124,36 @@ func logHandler() gin.HandlerFunc {
 		)
 
 		if errMsg == "" {
-			logger.Info("Request handled successfully")
+			reqLogger.Info("Request handled successfully")
 		} else {
-			logger.Error("Request failed", zap.String("error", errMsg))
+			reqLogger.Error("Request failed", zap.String("error", errMsg))
 		}
 	}
 }
 
+// 请求超时 超时后请求ctx被取消 下游的数据库与Redis操作随之中止
+func timeoutHandler(timeout time.Duration) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if timeout <= 0 {
+			ctx.Next()
+			return
+		}
+
+		c, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
+
+		ctx.Request = ctx.Request.WithContext(c)
+		ctx.Next()
+	}
+}
+
 // 指标记录中间件
 func metricsHandler(metricsClient metrics.Client) gin.HandlerFunc {
+	requests := metricsClient.Counter("http_requests_total", "Total number of HTTP requests", "action", "status")
+	durations := metricsClient.Histogram("http_request_duration_seconds", "HTTP request duration in seconds", metrics.DefaultBuckets, "action", "status")
+	// 限流与配额超限统一返回429
+	quotaRejections := metricsClient.Counter("quota_rejections_total", "Total number of requests rejected by rate limit or quota", "action")
+
 	return func(ctx *gin.Context) {
 		start := time.Now()
 		ctx.Next()
@@ -154,7 +184,61 @@ func metricsHandler(metricsClient metrics.Client) gin.HandlerFunc {
 			status = "2xx"
 		}
 
-		metricsClient.Inc(action, status, 1)
-		metricsClient.ObserveDuration(action, status, cost)
+		requests.Inc(action, status)
+		durations.Observe(cost, action, status)
+		if statusCode == http.StatusTooManyRequests {
+			quotaRejections.Inc(action)
+		}
+	}
+}
+
+// panic恢复 记录日志与指标后返回统一的错误格式
+func recoveryHandler(metricsClient metrics.Client) gin.HandlerFunc {
+	panics := metricsClient.Counter("http_panics_total", "Total number of recovered panics in HTTP handlers", "action")
+
+	return func(ctx *gin.Context) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+
+			action := ctx.Request.Method + " " + ctx.FullPath()
+			panics.Inc(action)
+
+			// panic值可能包含请求数据 记录前脱敏
+			err := fmt.Errorf("panic: %s", logger.Redact(fmt.Sprint(p)))
+			logger.FromContext(ctx.Request.Context()).Named("http").Error("Panic recovered",
+				zap.String("action", action),
+				zap.String("panic", err.Error()),
+				zap.String("stack", logger.Redact(string(debug.Stack()))),
+			)
+
+			_ = ctx.Error(err)
+			// 客户端已断开 无法再写入响应
+			if isBrokenPipe(p) {
+				ctx.Abort()
+				return
+			}
+			response.Error(ctx, err)
+		}()
+
+		ctx.Next()
+	}
+}
+
+func isBrokenPipe(p any) bool {
+	err, ok := p.(error)
+	if !ok {
+		return false
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var sysErr *os.SyscallError
+	if !errors.As(opErr, &sysErr) {
+		return false
 	}
+	return errors.Is(sysErr.Err, syscall.EPIPE) || errors.Is(sysErr.Err, syscall.ECONNRESET)
 }
@@ -0,0 +1,66 @@
+package teamaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/utils"
+)
+
+// 团队角色
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// Access 用户在团队中的角色 团队所有者不一定有成员记录
+type Access struct {
+	OwnerID string
+	Role    string
+}
+
+// IsAdmin 团队所有者和管理员可以管理团队
+func (a *Access) IsAdmin() bool {
+	return a.Role == RoleOwner || a.Role == RoleAdmin
+}
+
+// Find 查询用户在团队中的角色 非成员返回ErrTeamPermissionDenied
+func Find(ctx context.Context, exec boil.ContextExecutor, teamID, userID string) (*Access, error) {
+	if !utils.IsUUID(teamID) {
+		return nil, codes.ErrTeamNotFound
+	}
+
+	team, err := orm.Teams(orm.TeamWhere.TeamID.EQ(teamID)).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if team.OwnerID == userID {
+		return &Access{OwnerID: team.OwnerID, Role: RoleOwner}, nil
+	}
+
+	member, err := orm.TeamMembers(
+		orm.TeamMemberWhere.OwnerID.EQ(team.OwnerID),
+		orm.TeamMemberWhere.TeamID.EQ(teamID),
+		orm.TeamMemberWhere.UserID.EQ(userID),
+		orm.TeamMemberWhere.Status.EQ("active"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrTeamPermissionDenied
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return &Access{OwnerID: team.OwnerID, Role: member.Role}, nil
+}
@@ -0,0 +1,24 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// 探针请求频繁且无排查价值 不生成span
+var untracedPaths = map[string]struct{}{
+	"/healthz": {},
+	"/readyz":  {},
+}
+
+// Middleware 为每个请求创建server span 并从请求头提取上游trace-context
+func Middleware() gin.HandlerFunc {
+	return otelgin.Middleware(serviceName,
+		otelgin.WithFilter(func(r *http.Request) bool {
+			_, skip := untracedPaths[r.URL.Path]
+			return !skip
+		}),
+	)
+}
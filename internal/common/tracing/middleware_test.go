@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"sass-scaffold/internal/common/config"
+)
+
+// 使用同步导出的内存exporter span结束后即可读取
+func setupExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	if err := Init(config.TracingConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+	return exporter
+}
+
+func newEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(Middleware())
+	engine.GET("/v1/users/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	engine.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return engine
+}
+
+func TestMiddlewareCreatesServerSpan(t *testing.T) {
+	exporter := setupExporter(t)
+
+	parent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/42", nil)
+	req.Header.Set("traceparent", parent)
+	newEngine().ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("span数量=%d 期望1", len(spans))
+	}
+	span := spans[0]
+	if span.SpanKind != trace.SpanKindServer {
+		t.Fatalf("span kind=%v 期望server", span.SpanKind)
+	}
+	if span.Name != "GET /v1/users/:id" {
+		t.Fatalf("span名称=%q 应使用路由模板", span.Name)
+	}
+	if got := span.SpanContext.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("trace id=%s 未沿用上游trace-context", got)
+	}
+}
+
+func TestMiddlewareSkipsProbes(t *testing.T) {
+	exporter := setupExporter(t)
+
+	newEngine().ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Fatalf("探针请求生成了%d个span", n)
+	}
+}
@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+
+	"sass-scaffold/internal/common/config"
+)
+
+// 导出方式
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+var (
+	provider    *sdktrace.TracerProvider
+	serviceName = "sass-scaffold"
+)
+
+// Init 设置全局TracerProvider与W3C trace-context传播
+// 未启用时保持otel默认的空实现 各处埋点开销可忽略
+func Init(cfg config.TracingConfig) error {
+	// 未启用时同样解析上游trace-context 便于日志关联
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if cfg.ServiceName != "" {
+		serviceName = cfg.ServiceName
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		clientOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			clientOpts = append(clientOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), clientOpts...)
+		if err != nil {
+			return errors.WithMessage(err, "otlp exporter创建失败")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	default:
+		return errors.Errorf("不支持的TRACING_EXPORTER %s", cfg.Exporter)
+	}
+
+	provider = sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	return nil
+}
+
+// Close 导出剩余的span 应在服务关闭后调用
+func Close(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	return provider.Shutdown(ctx)
+}
+
+// NewHTTPClient 创建注入trace-context的http.Client 出站请求生成client span
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return NewHTTPClientWithTransport(timeout, http.DefaultTransport)
+}
+
+// NewHTTPClientWithTransport 在自定义Transport外包装trace-context注入
+func NewHTTPClientWithTransport(timeout time.Duration, base http.RoundTripper) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: otelhttp.NewTransport(base),
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
 	"strconv"
 )
 
@@ -13,3 +14,9 @@ func GetID(ctx *gin.Context) (int, error) {
 	}
 	return id, nil
 }
+
+// IsUUID 路径参数是否为合法UUID 非法值直接查询UUID列会导致数据库报错
+func IsUUID(s string) bool {
+	_, err := uuid.FromString(s)
+	return err == nil
+}
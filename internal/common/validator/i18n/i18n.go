@@ -2,6 +2,7 @@ package i18n
 
 import (
 	"sass-scaffold/internal/common/validator/register"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -117,16 +118,31 @@ func TranslateError(err error, lang ...string) ValidatorError {
 }
 
 func GetTranslateLang(ctx *gin.Context) string {
-	acceptLang := ctx.GetHeader("Accept-Language")
+	return ParseAcceptLanguage(ctx.GetHeader("Accept-Language"), "en", "zh")
+}
 
-	// 转换为小写并分割
-	acceptLang = strings.ToLower(acceptLang)
+// ParseAcceptLanguage 按q值选择supported中最合适的语言 均不匹配时默认中文
+// 如 "en-US,en;q=0.9,zh;q=0.8" 返回en 只比较主语言标签
+func ParseAcceptLanguage(acceptLang string, supported ...string) string {
+	best, bestQ := "zh", 0.0
+	for _, part := range strings.Split(strings.ToLower(acceptLang), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
 
-	// 检查是否包含英文
-	if strings.Contains(acceptLang, "en") {
-		return "en"
+		for _, lang := range supported {
+			if primary == lang && q > bestQ {
+				best, bestQ = lang, q
+			}
+		}
 	}
-
-	// 默认中文
-	return "zh"
+	return best
 }
@@ -0,0 +1,87 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/introspection/domain"
+)
+
+const (
+	statusActive = "active"
+	roleOwner    = "owner"
+)
+
+type PSQLIntrospectionRepository struct {
+	db *sql.DB
+}
+
+func NewPSQLIntrospectionRepository(db *sql.DB) domain.IntrospectionRepository {
+	return &PSQLIntrospectionRepository{
+		db: db,
+	}
+}
+
+func (r *PSQLIntrospectionRepository) UserActive(ctx context.Context, userID string) (bool, error) {
+	exists, err := orm.Users(
+		orm.UserWhere.UserID.EQ(userID),
+		orm.UserWhere.Status.EQ(statusActive),
+	).Exists(ctx, r.db)
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *PSQLIntrospectionRepository) FindMemberships(ctx context.Context, userID string) ([]*domain.Membership, error) {
+	owned, err := orm.Teams(
+		orm.TeamWhere.OwnerID.EQ(userID),
+		orm.TeamWhere.Status.EQ(statusActive),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find owned teams: %w", err)
+	}
+
+	memberships := make([]*domain.Membership, 0, len(owned))
+	for _, team := range owned {
+		memberships = append(memberships, &domain.Membership{TeamID: team.TeamID, OwnerID: team.OwnerID, Role: roleOwner})
+	}
+
+	members, err := orm.TeamMembers(
+		orm.TeamMemberWhere.UserID.EQ(userID),
+		orm.TeamMemberWhere.Status.EQ(statusActive),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find team members: %w", err)
+	}
+	if len(members) == 0 {
+		return memberships, nil
+	}
+
+	// 已停用的团队不返回
+	teamIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		teamIDs = append(teamIDs, member.TeamID)
+	}
+	teams, err := orm.Teams(
+		orm.TeamWhere.TeamID.IN(teamIDs),
+		orm.TeamWhere.Status.EQ(statusActive),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member teams: %w", err)
+	}
+	active := make(map[string]struct{}, len(teams))
+	for _, team := range teams {
+		active[team.TeamID] = struct{}{}
+	}
+
+	for _, member := range members {
+		if _, ok := active[member.TeamID]; !ok || member.OwnerID == userID {
+			continue
+		}
+		memberships = append(memberships, &domain.Membership{TeamID: member.TeamID, OwnerID: member.OwnerID, Role: member.Role})
+	}
+	return memberships, nil
+}
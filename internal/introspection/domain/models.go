@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// 令牌类型 目前只支持内省access token
+const TokenTypeAccess = "access_token"
+
+// 令牌内省结果 令牌无效时只有Active为false
+type Introspection struct {
+	Active    bool      `json:"active"`
+	UserID    string    `json:"user_id,omitempty"`
+	Issuer    string    `json:"issuer,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// 令牌限定的权限范围 Unrestricted为true时不受限制
+	Scopes       []string      `json:"scopes,omitempty"`
+	Unrestricted bool          `json:"unrestricted,omitempty"`
+	Teams        []*Membership `json:"teams,omitempty"`
+}
+
+// 用户所属的团队 所有者的角色为owner
+type Membership struct {
+	TeamID  string `json:"team_id"`
+	OwnerID string `json:"owner_id"`
+	Role    string `json:"role"`
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+type IntrospectionRepository interface {
+	// UserActive 用户存在且未被禁用
+	UserActive(ctx context.Context, userID string) (bool, error)
+	// FindMemberships 用户拥有或加入的有效团队
+	FindMemberships(ctx context.Context, userID string) ([]*Membership, error)
+}
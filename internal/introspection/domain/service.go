@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type IntrospectionService interface {
+	// Introspect 校验access token并返回用户与团队信息 令牌无效时返回Active为false的结果
+	Introspect(ctx context.Context, token string) (*Introspection, error)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"strings"
+
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/introspection/domain"
+)
+
+type HttpHandler struct {
+	service domain.IntrospectionService
+	config  config.Source
+}
+
+func NewHttpHandler(service domain.IntrospectionService, source config.Source) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+		config:  source,
+	}
+}
+
+// clientSecret 按client_id查找当前配置的secret 支持热加载
+// 忽略格式错误的条目 未配置时拒绝所有调用
+func (h *HttpHandler) clientSecret(clientID string) (string, bool) {
+	for _, client := range h.config().Introspection.Clients {
+		id, secret, ok := strings.Cut(client.Value(), ":")
+		if ok && id != "" && secret != "" && id == clientID {
+			return secret, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/authguard"
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+// Introspect RFC 7662令牌内省 调用方使用HTTP Basic认证
+// 成功时直接返回RFC 7662格式的结果 不使用统一响应格式
+func (h *HttpHandler) Introspect(ctx *gin.Context) {
+	// 只按IP计数 防止暴力尝试客户端密钥
+	// client_id未经验证 按其计数会让任何人都能锁定已配置的客户端
+	clientID, secret, hasAuth := ctx.Request.BasicAuth()
+	guardKeys := []string{authguard.IPKey(ctx.ClientIP())}
+	if err := authguard.Check(ctx.Request.Context(), guardKeys...); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if !hasAuth || !h.authenticate(clientID, secret) {
+		authguard.Fail(ctx.Request.Context(), guardKeys...)
+		ctx.Header("WWW-Authenticate", `Basic realm="introspection"`)
+		response.Error(ctx, codes.ErrIntrospectionUnauthorized)
+		return
+	}
+	authguard.Succeed(ctx.Request.Context(), guardKeys...)
+
+	// 内省结果包含用户信息 不允许中间代理缓存
+	ctx.Header("Cache-Control", "no-store")
+
+	req := new(IntrospectRequest)
+	if err := ctx.ShouldBind(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	// 只支持access token 其他类型的令牌一律视为无效
+	if req.TokenTypeHint != "" && req.TokenTypeHint != TokenTypeAccess {
+		ctx.JSON(http.StatusOK, &IntrospectResponse{Active: false})
+		return
+	}
+
+	result, err := h.service.Introspect(ctx.Request.Context(), req.Token)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	logger.FromContext(ctx.Request.Context()).Debug("令牌内省",
+		zap.String("client_id", clientID),
+		zap.Bool("active", result.Active),
+	)
+
+	ctx.JSON(http.StatusOK, DomainIntrospectionToResponse(result))
+}
+
+func (h *HttpHandler) authenticate(clientID, secret string) bool {
+	expected, ok := h.clientSecret(clientID)
+	return ok && subtle.ConstantTimeCompare([]byte(secret), []byte(expected)) == 1
+}
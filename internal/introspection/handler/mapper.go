@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"strings"
+
+	"sass-scaffold/internal/introspection/domain"
+)
+
+// TokenTypeAccess 内省请求与响应中的令牌类型
+const TokenTypeAccess = domain.TokenTypeAccess
+
+// HTTP 请求/响应模型 字段名遵循RFC 7662
+type IntrospectRequest struct {
+	Token         string `form:"token" json:"token" binding:"required"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"`
+}
+
+type IntrospectResponse struct {
+	Active    bool   `json:"active"`
+	Subject   string `json:"sub,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	// 空格分隔的权限范围
+	Scope string `json:"scope,omitempty"`
+	// 扩展字段 令牌不受scope限制
+	Unrestricted bool            `json:"unrestricted,omitempty"`
+	Teams        []*TeamResponse `json:"teams,omitempty"`
+}
+
+type TeamResponse struct {
+	TeamID  string `json:"team_id"`
+	OwnerID string `json:"owner_id"`
+	Role    string `json:"role"`
+}
+
+// 转换函数
+func DomainIntrospectionToResponse(result *domain.Introspection) *IntrospectResponse {
+	if !result.Active {
+		return &IntrospectResponse{Active: false}
+	}
+
+	res := &IntrospectResponse{
+		Active:       true,
+		Subject:      result.UserID,
+		TokenType:    TokenTypeAccess,
+		Issuer:       result.Issuer,
+		Scope:        strings.Join(result.Scopes, " "),
+		Unrestricted: result.Unrestricted,
+		Teams:        make([]*TeamResponse, 0, len(result.Teams)),
+	}
+	if !result.IssuedAt.IsZero() {
+		res.IssuedAt = result.IssuedAt.Unix()
+	}
+	if !result.ExpiresAt.IsZero() {
+		res.ExpiresAt = result.ExpiresAt.Unix()
+	}
+	for _, team := range result.Teams {
+		res.Teams = append(res.Teams, &TeamResponse{
+			TeamID:  team.TeamID,
+			OwnerID: team.OwnerID,
+			Role:    team.Role,
+		})
+	}
+	return res
+}
@@ -0,0 +1,26 @@
+package introspection
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/introspection/handler"
+)
+
+// 接口文档 修改router_v1.go中的路由时同步修改
+func init() {
+	openapi.Register(openapi.Operation{
+		Method:      http.MethodPost,
+		Path:        "/v1/oauth/introspect",
+		Summary:     "令牌内省",
+		Description: "RFC 7662 供其他服务校验access token 调用方使用INTROSPECTION_CLIENTS中的client_id与secret进行Basic认证 令牌无效时返回active为false",
+		Tags:        []string{"oauth"},
+		ClientAuth:  true,
+		Request:     handler.IntrospectRequest{},
+		FormRequest: true,
+		Response:    handler.IntrospectResponse{},
+		RawResponse: true,
+		Errors:      []codes.ErrCode{codes.ErrIntrospectionUnauthorized, codes.ErrAuthLocked},
+	})
+}
@@ -0,0 +1,15 @@
+package introspection
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/introspection/handler"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+	// 服务间调用 使用HTTP Basic认证 不走用户认证
+	g := r.Group("/v1/oauth")
+	{
+		g.POST("/introspect", handler.Introspect)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/introspection/domain"
+)
+
+type introspectionService struct {
+	repo     domain.IntrospectionRepository
+	verifier auth.Verifier
+}
+
+// 与认证中间件使用同一Verifier 校验规则与密钥轮换保持一致
+func NewIntrospectionService(repo domain.IntrospectionRepository, verifier auth.Verifier) domain.IntrospectionService {
+	return &introspectionService{
+		repo:     repo,
+		verifier: verifier,
+	}
+}
+
+func (s *introspectionService) Introspect(ctx context.Context, token string) (*domain.Introspection, error) {
+	inactive := &domain.Introspection{Active: false}
+
+	// 过期、签名错误等均视为无效令牌 不区分原因
+	claims, _, err := s.verifier.ValidateAccessToken(ctx, token)
+	if err != nil || claims.UserID == "" {
+		return inactive, nil
+	}
+
+	userID := claims.UserID
+	active, err := s.repo.UserActive(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return inactive, nil
+	}
+
+	teams, err := s.repo.FindMemberships(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Introspection{
+		Active:       true,
+		UserID:       userID,
+		Issuer:       claims.Issuer,
+		IssuedAt:     claims.IssuedAt,
+		ExpiresAt:    claims.ExpiresAt,
+		Scopes:       claims.Scopes,
+		Unrestricted: !claims.Restricted,
+		Teams:        teams,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/introspection/domain"
+)
+
+type stubVerifier map[string]auth.Claims
+
+func (v stubVerifier) ValidateAccessToken(_ context.Context, token string) (auth.Claims, bool, error) {
+	claims, ok := v[token]
+	if !ok {
+		return auth.Claims{}, false, errors.New("invalid token")
+	}
+	return claims, false, nil
+}
+
+type stubRepo struct{ active bool }
+
+func (r stubRepo) UserActive(context.Context, string) (bool, error) {
+	return r.active, nil
+}
+
+func (r stubRepo) FindMemberships(context.Context, string) ([]*domain.Membership, error) {
+	return []*domain.Membership{{TeamID: "t1", OwnerID: "u1", Role: "owner"}}, nil
+}
+
+func TestIntrospect(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	verifier := stubVerifier{
+		"scoped": {UserID: "u1", Scopes: []string{"audit:read"}, Restricted: true, Issuer: "iss", ExpiresAt: exp},
+		"login":  {UserID: "u1", Issuer: "iss", ExpiresAt: exp},
+	}
+	s := NewIntrospectionService(stubRepo{active: true}, verifier)
+
+	res, err := s.Introspect(context.Background(), "scoped")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Active || res.UserID != "u1" || res.Issuer != "iss" || !res.ExpiresAt.Equal(exp) {
+		t.Fatalf("结果不符: %+v", res)
+	}
+	if len(res.Scopes) != 1 || res.Scopes[0] != "audit:read" || res.Unrestricted {
+		t.Fatalf("scope不符: %+v", res)
+	}
+	// 未携带restricted声明的令牌不受限制
+	if res, _ := s.Introspect(context.Background(), "login"); !res.Active || !res.Unrestricted {
+		t.Fatalf("登录令牌应不受限制: %+v", res)
+	}
+	if len(res.Teams) != 1 {
+		t.Fatalf("团队不符: %+v", res.Teams)
+	}
+
+	if res, _ := s.Introspect(context.Background(), "bad"); res.Active {
+		t.Fatal("无效令牌应返回active=false")
+	}
+	disabled := NewIntrospectionService(stubRepo{active: false}, verifier)
+	if res, _ := disabled.Introspect(context.Background(), "scoped"); res.Active {
+		t.Fatal("禁用用户的令牌应返回active=false")
+	}
+}
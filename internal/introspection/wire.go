@@ -0,0 +1,28 @@
+//go:build wireinject
+// +build wireinject
+
+package introspection
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/introspection/adapters"
+	"sass-scaffold/internal/introspection/handler"
+	"sass-scaffold/internal/introspection/service"
+)
+
+func InitV1(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewIntrospectionService,
+		adapters.NewPSQLIntrospectionRepository,
+		auth.VerifierOf,
+		config.ProviderSet,
+		datastore.ProviderSet,
+	)
+	return nil
+}
@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"github.com/volatiletech/null/v8"
+
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/maillog/domain"
+)
+
+func domainDeliveryToORM(delivery *domain.Delivery) *orm.EmailDelivery {
+	if delivery == nil {
+		return nil
+	}
+
+	ormDelivery := &orm.EmailDelivery{
+		MessageID: delivery.MessageID,
+		Provider:  delivery.Provider,
+		Recipient: delivery.Recipient,
+		Subject:   delivery.Subject,
+		Status:    delivery.Status,
+		CreatedAt: delivery.CreatedAt,
+		UpdatedAt: delivery.UpdatedAt,
+	}
+
+	if delivery.ProviderMessageID != "" {
+		ormDelivery.ProviderMessageID = null.StringFrom(delivery.ProviderMessageID)
+	}
+
+	if delivery.Template != "" {
+		ormDelivery.Template = null.StringFrom(delivery.Template)
+	}
+
+	if delivery.Error != "" {
+		ormDelivery.Error = null.StringFrom(delivery.Error)
+	}
+
+	return ormDelivery
+}
+
+func ormDeliveryToDomain(ormDelivery *orm.EmailDelivery) *domain.Delivery {
+	if ormDelivery == nil {
+		return nil
+	}
+
+	delivery := &domain.Delivery{
+		MessageID: ormDelivery.MessageID,
+		Provider:  ormDelivery.Provider,
+		Recipient: ormDelivery.Recipient,
+		Subject:   ormDelivery.Subject,
+		Status:    ormDelivery.Status,
+		CreatedAt: ormDelivery.CreatedAt,
+		UpdatedAt: ormDelivery.UpdatedAt,
+	}
+
+	if ormDelivery.ProviderMessageID.Valid {
+		delivery.ProviderMessageID = ormDelivery.ProviderMessageID.String
+	}
+
+	if ormDelivery.Template.Valid {
+		delivery.Template = ormDelivery.Template.String
+	}
+
+	if ormDelivery.Error.Valid {
+		delivery.Error = ormDelivery.Error.String
+	}
+
+	return delivery
+}
+
+func domainEventToORM(event *domain.Event) *orm.EmailEvent {
+	if event == nil {
+		return nil
+	}
+
+	ormEvent := &orm.EmailEvent{
+		EventID:    event.ID,
+		MessageID:  event.MessageID,
+		EventType:  event.Type,
+		OccurredAt: event.OccurredAt,
+		CreatedAt:  event.CreatedAt,
+	}
+
+	if event.Reason != "" {
+		ormEvent.Reason = null.StringFrom(event.Reason)
+	}
+
+	if len(event.Payload) > 0 {
+		ormEvent.Payload = null.JSONFrom(event.Payload)
+	}
+
+	return ormEvent
+}
@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/maillog/domain"
+	"time"
+)
+
+type PSQLMailLogRepository struct {
+	db *sql.DB
+}
+
+func NewPSQLMailLogRepository(db *sql.DB) domain.MailLogRepository {
+	return &PSQLMailLogRepository{
+		db: db,
+	}
+}
+
+func (r *PSQLMailLogRepository) CreateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	ormDelivery := domainDeliveryToORM(delivery)
+
+	if err := ormDelivery.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to create email delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *PSQLMailLogRepository) FindDelivery(ctx context.Context, messageID string) (*domain.Delivery, error) {
+	ormDelivery, err := orm.EmailDeliveries(
+		orm.EmailDeliveryWhere.MessageID.EQ(messageID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrEmailDeliveryNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormDeliveryToDomain(ormDelivery), nil
+}
+
+func (r *PSQLMailLogRepository) UpdateDeliveryStatus(ctx context.Context, messageID, status string) error {
+	rows, err := orm.EmailDeliveries(
+		orm.EmailDeliveryWhere.MessageID.EQ(messageID),
+	).UpdateAll(ctx, r.db, orm.M{
+		orm.EmailDeliveryColumns.Status:    status,
+		orm.EmailDeliveryColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update email delivery: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrEmailDeliveryNotFound
+	}
+	return nil
+}
+
+func (r *PSQLMailLogRepository) CreateEvent(ctx context.Context, event *domain.Event) error {
+	ormEvent := domainEventToORM(event)
+
+	if err := ormEvent.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to create email event: %w", err)
+	}
+	return nil
+}
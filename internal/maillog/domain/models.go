@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 投递状态
+const (
+	StatusSent       = "sent"
+	StatusFailed     = "failed"
+	StatusDelivered  = "delivered"
+	StatusBounced    = "bounced"
+	StatusComplained = "complained"
+)
+
+// 服务商回调的事件类型 与对应的投递状态同名
+const (
+	EventDelivered  = StatusDelivered
+	EventBounced    = StatusBounced
+	EventComplained = StatusComplained
+)
+
+// 邮件投递记录
+type Delivery struct {
+	MessageID         string    `json:"message_id"`
+	Provider          string    `json:"provider"`
+	ProviderMessageID string    `json:"provider_message_id,omitempty"`
+	Recipient         string    `json:"recipient"`
+	Subject           string    `json:"subject"`
+	Template          string    `json:"template,omitempty"`
+	Status            string    `json:"status"`
+	Error             string    `json:"error,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// 退信、投诉、送达等回执事件
+type Event struct {
+	ID         string          `json:"id"`
+	MessageID  string          `json:"message_id"`
+	Type       string          `json:"type"`
+	Reason     string          `json:"reason,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// IsValidEventType 是否为支持的回执事件类型
+func IsValidEventType(t string) bool {
+	switch t {
+	case EventDelivered, EventBounced, EventComplained:
+		return true
+	}
+	return false
+}
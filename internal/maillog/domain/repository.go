@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+type MailLogRepository interface {
+	CreateDelivery(ctx context.Context, delivery *Delivery) error
+	FindDelivery(ctx context.Context, messageID string) (*Delivery, error)
+	UpdateDeliveryStatus(ctx context.Context, messageID, status string) error
+
+	CreateEvent(ctx context.Context, event *Event) error
+}
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type MailLogService interface {
+	// HandleEvent 处理服务商回调的回执事件
+	HandleEvent(ctx context.Context, event *Event) error
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/maillog/domain"
+)
+
+type HttpHandler struct {
+	service domain.MailLogService
+	config  config.Source
+}
+
+func NewHttpHandler(service domain.MailLogService, source config.Source) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+		config:  source,
+	}
+}
+
+// webhookSecret 当前配置的回调密钥 支持热加载 未配置时拒绝所有回调
+func (h *HttpHandler) webhookSecret() string {
+	return h.config().Email.WebhookSecret.Value()
+}
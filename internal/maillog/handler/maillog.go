@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/reskit/response"
+)
+
+// ReceiveEvent 接收服务商的退信、投诉、送达回调
+func (h *HttpHandler) ReceiveEvent(ctx *gin.Context) {
+	token := ctx.GetHeader(HeaderWebhookToken)
+	secret := h.webhookSecret()
+	if secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		response.Error(ctx, codes.ErrEmailWebhookUnauthorized)
+		return
+	}
+
+	req := new(EventRequest)
+	if err := ctx.ShouldBindBodyWithJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	// ShouldBindBodyWithJSON 会缓存请求体 回调原文随事件一起保存
+	body := ctx.MustGet(gin.BodyBytesKey).([]byte)
+	if err := h.service.HandleEvent(ctx.Request.Context(), HTTPEventToDomain(req, body)); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, nil)
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"sass-scaffold/internal/maillog/domain"
+)
+
+// HeaderWebhookToken 服务商回调时携带的共享密钥
+const HeaderWebhookToken = "X-Email-Webhook-Token"
+
+// HTTP 请求/响应模型
+type EventRequest struct {
+	MessageID  string    `json:"message_id" binding:"required,max=255"`
+	Type       string    `json:"type" binding:"required"`
+	Reason     string    `json:"reason,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// 转换函数
+func HTTPEventToDomain(req *EventRequest, payload []byte) *domain.Event {
+	return &domain.Event{
+		MessageID:  req.MessageID,
+		Type:       req.Type,
+		Reason:     req.Reason,
+		Payload:    json.RawMessage(payload),
+		OccurredAt: req.OccurredAt,
+	}
+}
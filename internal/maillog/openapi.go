@@ -0,0 +1,22 @@
+package maillog
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/maillog/handler"
+)
+
+// 接口文档 修改router_v1.go中的路由时同步修改
+func init() {
+	openapi.Register(openapi.Operation{
+		Method:  http.MethodPost,
+		Path:    "/v1/email/events",
+		Summary: "邮件服务商回调",
+		Tags:    []string{"email"},
+		Headers: map[string]string{handler.HeaderWebhookToken: "与EMAIL_WEBHOOK_SECRET一致的共享密钥"},
+		Request: handler.EventRequest{},
+		Errors:  []codes.ErrCode{codes.ErrEmailWebhookUnauthorized, codes.ErrEmailEventTypeInvalid, codes.ErrEmailDeliveryNotFound},
+	})
+}
@@ -0,0 +1,15 @@
+package maillog
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/maillog/handler"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+	// 服务商回调 使用共享密钥校验 不走用户认证
+	g := r.Group("/v1/email")
+	{
+		g.POST("/events", handler.ReceiveEvent)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/maillog/domain"
+)
+
+type mailLogService struct {
+	repo domain.MailLogRepository
+}
+
+func NewMailLogService(repo domain.MailLogRepository, bus *eventbus.Bus) domain.MailLogService {
+	s := &mailLogService{
+		repo: repo,
+	}
+	s.subscribe(bus)
+	return s
+}
+
+// 订阅邮件投递结果 记录投递日志
+func (s *mailLogService) subscribe(bus *eventbus.Bus) {
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.EmailSent) error {
+		return s.repo.CreateDelivery(ctx, &domain.Delivery{
+			MessageID:         e.MessageID,
+			Provider:          e.Provider,
+			ProviderMessageID: e.ProviderMessageID,
+			Recipient:         e.Recipient,
+			Subject:           e.Subject,
+			Template:          e.Template,
+			Status:            domain.StatusSent,
+			CreatedAt:         e.OccurredAt,
+			UpdatedAt:         e.OccurredAt,
+		})
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.EmailFailed) error {
+		return s.repo.CreateDelivery(ctx, &domain.Delivery{
+			MessageID: e.MessageID,
+			Provider:  "none",
+			Recipient: e.Recipient,
+			Subject:   e.Subject,
+			Template:  e.Template,
+			Status:    domain.StatusFailed,
+			Error:     e.Error,
+			CreatedAt: e.OccurredAt,
+			UpdatedAt: e.OccurredAt,
+		})
+	})
+}
+
+func (s *mailLogService) HandleEvent(ctx context.Context, event *domain.Event) error {
+	if !domain.IsValidEventType(event.Type) {
+		return codes.ErrEmailEventTypeInvalid.WithDetail(map[string]any{
+			"type": event.Type,
+		})
+	}
+
+	delivery, err := s.repo.FindDelivery(ctx, event.MessageID)
+	if err != nil {
+		return err
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	event.CreatedAt = time.Now()
+	if err := s.repo.CreateEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// 退信和投诉是终态 之后到达的送达回执不覆盖
+	if event.Type == domain.EventDelivered && delivery.Status != domain.StatusSent {
+		return nil
+	}
+	return s.repo.UpdateDeliveryStatus(ctx, delivery.MessageID, event.Type)
+}
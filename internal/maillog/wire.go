@@ -0,0 +1,28 @@
+//go:build wireinject
+// +build wireinject
+
+package maillog
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/maillog/adapters"
+	"sass-scaffold/internal/maillog/handler"
+	"sass-scaffold/internal/maillog/service"
+)
+
+func InitV1(r *gin.RouterGroup) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewMailLogService,
+		adapters.NewPSQLMailLogRepository,
+		eventbus.GetBusInstance,
+		config.ProviderSet,
+		datastore.ProviderSet,
+	)
+	return nil
+}
@@ -18,6 +18,7 @@ func DomainUserToORM(user *domain.User) *orm.User {
 		Name:		user.Name,
 		EmailVerified:	user.EmailVerified,
 		Status:		user.Status,
+		Locale:		user.Locale,
 		CreatedAt:	user.CreatedAt,
 		UpdatedAt:	user.UpdatedAt,
 	}
@@ -64,6 +65,7 @@ func ORMUserToDomain(ormUser *orm.User) *domain.User {
 		Name:		ormUser.Name,
 		EmailVerified:	ormUser.EmailVerified,
 		Status:		ormUser.Status,
+		Locale:		ormUser.Locale,
 		CreatedAt:	ormUser.CreatedAt,
 		UpdatedAt:	ormUser.UpdatedAt,
 	}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
-	"os"
 	"sass-scaffold/internal/common/reskit/codes"
 	"strings"
 	"time"
@@ -22,30 +20,13 @@ type PSQLUserRepository struct {
 	db *sql.DB
 }
 
-func NewPSQLUserRepository() domain.UserRepository {
-	host := os.Getenv("PSQL_HOST")
-	port := os.Getenv("PSQL_PORT")
-	user := os.Getenv("PSQL_USERNAME")
-	password := os.Getenv("PSQL_PASSWORD")
-	dbname := os.Getenv("PSQL_DB_NAME")
-	sslmode := os.Getenv("PSQL_SSL_MODE")
-
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		host, port, user, password, dbname, sslmode,
-	)
-
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		panic(err)
-	}
+func NewPSQLUserRepository(db *sql.DB) domain.UserRepository {
 	return &PSQLUserRepository{
 		db: db,
 	}
 }
 
-func (r *PSQLUserRepository) FindByID(userID string) (*domain.User, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) FindByID(ctx context.Context, userID string) (*domain.User, error) {
 	ormUser, err := orm.Users(orm.UserWhere.UserID.EQ(userID)).One(ctx, r.db)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -56,8 +37,7 @@ func (r *PSQLUserRepository) FindByID(userID string) (*domain.User, error) {
 	return ORMUserToDomain(ormUser), nil
 }
 
-func (r *PSQLUserRepository) FindByEmail(email string) (*domain.User, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	ormUser, err := orm.Users(orm.UserWhere.Email.EQ(email)).One(ctx, r.db)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -68,8 +48,7 @@ func (r *PSQLUserRepository) FindByEmail(email string) (*domain.User, error) {
 	return ORMUserToDomain(ormUser), nil
 }
 
-func (r *PSQLUserRepository) Create(user *domain.User) (*domain.User, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
 	ormUser := DomainUserToORM(user)
 
 	if err := ormUser.Insert(ctx, r.db, boil.Infer()); err != nil {
@@ -79,8 +58,7 @@ func (r *PSQLUserRepository) Create(user *domain.User) (*domain.User, error) {
 	return ORMUserToDomain(ormUser), nil
 }
 
-func (r *PSQLUserRepository) Update(user *domain.User) (*domain.User, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	ormUser := DomainUserToORM(user)
 
 	_, err := ormUser.Update(ctx, r.db, boil.Infer())
@@ -91,8 +69,7 @@ func (r *PSQLUserRepository) Update(user *domain.User) (*domain.User, error) {
 	return ORMUserToDomain(ormUser), nil
 }
 
-func (r *PSQLUserRepository) FindByOAuthID(provider, oauthID string) (*domain.User, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) FindByOAuthID(ctx context.Context, provider, oauthID string) (*domain.User, error) {
 	var ormUser *orm.User
 	var err error
 
@@ -123,8 +100,7 @@ func (r *PSQLUserRepository) FindByOAuthID(provider, oauthID string) (*domain.Us
 	return ORMUserToDomain(ormUser), nil
 }
 
-func (r *PSQLUserRepository) UpdateLastLogin(userID string) error {
-	ctx := context.Background()
+func (r *PSQLUserRepository) UpdateLastLogin(ctx context.Context, userID string) error {
 	ormUser, err := orm.Users(orm.UserWhere.UserID.EQ(userID)).One(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("failed to find user: %w", err)
@@ -135,8 +111,7 @@ func (r *PSQLUserRepository) UpdateLastLogin(userID string) error {
 	return err
 }
 
-func (r *PSQLUserRepository) EmailExists(email string) (bool, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
 	exists, err := orm.Users(orm.UserWhere.Email.EQ(email)).Exists(ctx, r.db)
 	if err != nil {
 		return false, fmt.Errorf("database error: %w", err)
@@ -144,8 +119,7 @@ func (r *PSQLUserRepository) EmailExists(email string) (bool, error) {
 	return exists, nil
 }
 
-func (r *PSQLUserRepository) UsernameExists(username string) (bool, error) {
-	ctx := context.Background()
+func (r *PSQLUserRepository) UsernameExists(ctx context.Context, username string) (bool, error) {
 	exists, err := orm.Users(
 		orm.UserWhere.Username.EQ(null.StringFrom(username)),
 	).Exists(ctx, r.db)
@@ -155,7 +129,7 @@ func (r *PSQLUserRepository) UsernameExists(username string) (bool, error) {
 	return exists, nil
 }
 
-func (r *PSQLUserRepository) GenerateUniqueUsername(preferred string) (string, error) {
+func (r *PSQLUserRepository) GenerateUniqueUsername(ctx context.Context, preferred string) (string, error) {
 	// 清理用户名：只保留字母数字和下划线
 	cleaned := strings.Map(func(r rune) rune {
 		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
@@ -175,7 +149,7 @@ func (r *PSQLUserRepository) GenerateUniqueUsername(preferred string) (string, e
 	}
 
 	// 检查是否已存在
-	exists, err := r.UsernameExists(cleaned)
+	exists, err := r.UsernameExists(ctx, cleaned)
 	if err != nil {
 		return "", err
 	}
@@ -193,7 +167,7 @@ func (r *PSQLUserRepository) GenerateUniqueUsername(preferred string) (string, e
 			candidate = fmt.Sprintf("%s%d", cleaned[:maxBase], i)
 		}
 
-		exists, err := r.UsernameExists(candidate)
+		exists, err := r.UsernameExists(ctx, candidate)
 		if err != nil {
 			return "", err
 		}
@@ -0,0 +1,168 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/teamaccess"
+	"sass-scaffold/internal/user/domain"
+)
+
+const teamStatusActive = "active"
+
+type PSQLTeamRepository struct {
+	db *sql.DB
+}
+
+func NewPSQLTeamRepository(db *sql.DB) domain.TeamRepository {
+	return &PSQLTeamRepository{
+		db: db,
+	}
+}
+
+func (r *PSQLTeamRepository) FindTeamAccess(ctx context.Context, teamID, userID string) (*domain.TeamAccess, error) {
+	return teamaccess.Find(ctx, r.db, teamID, userID)
+}
+
+func (r *PSQLTeamRepository) CreateTeam(ctx context.Context, team *domain.Team) (*domain.Team, error) {
+	exists, err := orm.Teams(
+		orm.TeamWhere.OwnerID.EQ(team.OwnerID),
+		orm.TeamWhere.Name.EQ(team.Name),
+	).Exists(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if exists {
+		return nil, codes.ErrTeamAlreadyExists
+	}
+
+	ormTeam := &orm.Team{
+		OwnerID:     team.OwnerID,
+		Name:        team.Name,
+		Description: null.NewString(team.Description, team.Description != ""),
+		Status:      team.Status,
+		CreatedAt:   team.CreatedAt,
+		UpdatedAt:   team.UpdatedAt,
+	}
+	if err := ormTeam.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+	return ormTeamToDomain(ormTeam), nil
+}
+
+func (r *PSQLTeamRepository) FindTeamByID(ctx context.Context, teamID string) (*domain.Team, error) {
+	ormTeam, err := orm.Teams(orm.TeamWhere.TeamID.EQ(teamID)).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormTeamToDomain(ormTeam), nil
+}
+
+// FindUserTeams 用户拥有的团队和已加入的团队
+func (r *PSQLTeamRepository) FindUserTeams(ctx context.Context, userID string) ([]*domain.Team, error) {
+	members, err := orm.TeamMembers(
+		orm.TeamMemberWhere.UserID.EQ(userID),
+		orm.TeamMemberWhere.Status.EQ(domain.MemberStatusActive),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find team members: %w", err)
+	}
+
+	teamIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		teamIDs = append(teamIDs, member.TeamID)
+	}
+
+	scope := orm.TeamWhere.OwnerID.EQ(userID)
+	if len(teamIDs) > 0 {
+		scope = qm.Expr(scope, qm.Or2(orm.TeamWhere.TeamID.IN(teamIDs)))
+	}
+
+	ormTeams, err := orm.Teams(
+		orm.TeamWhere.Status.EQ(teamStatusActive),
+		scope,
+		qm.OrderBy(orm.TeamColumns.CreatedAt),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find teams: %w", err)
+	}
+
+	teams := make([]*domain.Team, 0, len(ormTeams))
+	for _, t := range ormTeams {
+		teams = append(teams, ormTeamToDomain(t))
+	}
+	return teams, nil
+}
+
+func (r *PSQLTeamRepository) FindTeamMember(ctx context.Context, ownerID, teamID, userID string) (*domain.TeamMember, error) {
+	ormMember, err := orm.TeamMembers(
+		orm.TeamMemberWhere.OwnerID.EQ(ownerID),
+		orm.TeamMemberWhere.TeamID.EQ(teamID),
+		orm.TeamMemberWhere.UserID.EQ(userID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrTeamMemberNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormMemberToDomain(ormMember), nil
+}
+
+// SaveTeamMember 按主键插入或更新 被移除的成员可以重新邀请
+func (r *PSQLTeamRepository) SaveTeamMember(ctx context.Context, member *domain.TeamMember) error {
+	ormMember := &orm.TeamMember{
+		OwnerID:   member.OwnerID,
+		TeamID:    member.TeamID,
+		UserID:    member.UserID,
+		Role:      member.Role,
+		JoinedAt:  member.JoinedAt,
+		InvitedBy: null.NewString(member.InvitedBy, member.InvitedBy != ""),
+		Status:    member.Status,
+	}
+
+	err := ormMember.Upsert(ctx, r.db, true,
+		[]string{orm.TeamMemberColumns.OwnerID, orm.TeamMemberColumns.TeamID, orm.TeamMemberColumns.UserID},
+		boil.Whitelist(orm.TeamMemberColumns.Role, orm.TeamMemberColumns.JoinedAt, orm.TeamMemberColumns.InvitedBy, orm.TeamMemberColumns.Status),
+		boil.Infer(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save team member: %w", err)
+	}
+	return nil
+}
+
+func ormTeamToDomain(t *orm.Team) *domain.Team {
+	return &domain.Team{
+		ID:          t.TeamID,
+		OwnerID:     t.OwnerID,
+		Name:        t.Name,
+		Description: t.Description.String,
+		Status:      t.Status,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func ormMemberToDomain(m *orm.TeamMember) *domain.TeamMember {
+	return &domain.TeamMember{
+		OwnerID:   m.OwnerID,
+		TeamID:    m.TeamID,
+		UserID:    m.UserID,
+		Role:      m.Role,
+		InvitedBy: m.InvitedBy.String,
+		JoinedAt:  m.JoinedAt,
+		Status:    m.Status,
+	}
+}
@@ -3,8 +3,6 @@ package adapters
 import (
 	"context"
 	"encoding/json"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,30 +16,7 @@ type RedisCache struct {
 	client *redis.Client
 }
 
-func NewRedisTokenCache() domain.TokenCache {
-	host := os.Getenv("REDIS_HOST")
-	port := os.Getenv("REDIS_PORT")
-	password := os.Getenv("REDIS_PASSWORD")
-	dbStr := os.Getenv("REDIS_DB")
-	poolSizeStr := os.Getenv("REDIS_POOL_SIZE")
-
-	db, _ := strconv.Atoi(dbStr)
-	poolSize, _ := strconv.Atoi(poolSizeStr)
-
-	addr := host + ":" + port
-
-	client := redis.NewClient(&redis.Options{
-		Addr:		addr,
-		DB:		db,
-		Password:	password,
-		PoolSize:	poolSize,
-	})
-
-	// 可选：ping 检查连接
-	if err := client.Ping(context.Background()).Err(); err != nil {
-		panic(err)
-	}
-
+func NewRedisTokenCache(client *redis.Client) domain.TokenCache {
 	return &RedisCache{client: client}
 }
 
@@ -50,7 +25,19 @@ const (
 	keyRefreshTokenMap		= "user_refresh_token_map"
 )
 
-func (ch *RedisCache) GenRefreshToken(payload domain.JwtPayload) (string, error) {
+// refreshField 哈希表的field 只取用户ID与随机码 刷新请求中不携带scope等其他声明
+func refreshField(payload domain.JwtPayload) (string, error) {
+	payloadByte, err := json.Marshal(struct {
+		UserID		string	`json:"user_id"`
+		RandomCode	string	`json:"random_code"`
+	}{payload.UserID, payload.RandomCode})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(payloadByte), nil
+}
+
+func (ch *RedisCache) GenRefreshToken(ctx context.Context, payload domain.JwtPayload) (string, error) {
 	refreshToken, err := utils.GenRandomHexToken()
 	if err != nil {
 		return "", errors.WithStack(err)
@@ -59,20 +46,19 @@ func (ch *RedisCache) GenRefreshToken(payload domain.JwtPayload) (string, error)
 	key := utils.GetRedisKey(keyRefreshTokenMap)
 	pipe := ch.client.Pipeline()
 
-	payloadByte, err := json.Marshal(payload)
+	payloadStr, err := refreshField(payload)
 	if err != nil {
-		return "", errors.WithStack(err)
+		return "", err
 	}
-	payloadStr := string(payloadByte)
 
-	if err := pipe.HSet(context.Background(), key, payloadStr, refreshToken).Err(); err != nil {
+	if err := pipe.HSet(ctx, key, payloadStr, refreshToken).Err(); err != nil {
 		return "", errors.WithStack(err)
 	}
 
-	pipe.HExpire(context.Background(), key, keyRefreshTokenMapDuration, payloadStr)
+	pipe.HExpire(ctx, key, keyRefreshTokenMapDuration, payloadStr)
 
 	// 执行Pipeline命令
-	_, err = pipe.Exec(context.Background())
+	_, err = pipe.Exec(ctx)
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
@@ -80,16 +66,15 @@ func (ch *RedisCache) GenRefreshToken(payload domain.JwtPayload) (string, error)
 	return refreshToken, nil
 }
 
-func (ch *RedisCache) ValidateRefreshToken(payload domain.JwtPayload, refreshToken string) error {
+func (ch *RedisCache) ValidateRefreshToken(ctx context.Context, payload domain.JwtPayload, refreshToken string) error {
 	key := utils.GetRedisKey(keyRefreshTokenMap)
 
-	payloadByte, err := json.Marshal(payload)
+	payloadStr, err := refreshField(payload)
 	if err != nil {
-		return errors.WithStack(err)
+		return err
 	}
-	payloadStr := string(payloadByte)
 
-	result, err := ch.client.HGet(context.Background(), key, payloadStr).Result()
+	result, err := ch.client.HGet(ctx, key, payloadStr).Result()
 
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
@@ -105,16 +90,15 @@ func (ch *RedisCache) ValidateRefreshToken(payload domain.JwtPayload, refreshTok
 	return nil
 }
 
-func (ch *RedisCache) ResetRefreshTokenExpiry(payload domain.JwtPayload) error {
+func (ch *RedisCache) ResetRefreshTokenExpiry(ctx context.Context, payload domain.JwtPayload) error {
 	key := utils.GetRedisKey(keyRefreshTokenMap)
 
-	payloadByte, err := json.Marshal(payload)
+	payloadStr, err := refreshField(payload)
 	if err != nil {
-		return errors.WithStack(err)
+		return err
 	}
-	payloadStr := string(payloadByte)
 
-	if err := ch.client.HExpire(context.Background(), key, keyRefreshTokenMapDuration, payloadStr).Err(); err != nil {
+	if err := ch.client.HExpire(ctx, key, keyRefreshTokenMapDuration, payloadStr).Err(); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
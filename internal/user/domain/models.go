@@ -1,6 +1,11 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/teamaccess"
+)
 
 // 业务实体（Domain Entity）
 type User struct {
@@ -18,14 +23,15 @@ type User struct {
 	UpdatedAt     time.Time  `json:"updated_at"`
 	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
 	Status        string     `json:"status"`
+	// 邮件等通知使用的语言
+	Locale string `json:"locale"`
 }
 
-type JwtPayload struct {
-	UserID     string `json:"user_id"`
-	RandomCode string `json:"random_code"`
-}
+// JwtPayload 令牌声明由认证中间件定义 用户模块负责签发
+type JwtPayload = auth.Claims
 
 type User2Token struct {
+	User         *User  `json:"user,omitempty"`
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
@@ -38,6 +44,8 @@ type OAuthUserInfo struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Avatar   string `json:"avatar_url"`
+	// 注册时请求的首选语言 已归一为支持的语言
+	Locale string `json:"-"`
 }
 
 // 用户资料更新（值对象）
@@ -45,6 +53,7 @@ type UserProfileUpdate struct {
 	Name     *string `json:"name,omitempty"`
 	Username *string `json:"username,omitempty"`
 	Avatar   *string `json:"avatar,omitempty"`
+	Locale   *string `json:"locale,omitempty"`
 }
 
 // 团队（为后续微服务做准备）
@@ -59,15 +68,33 @@ type Team struct {
 }
 
 type TeamMember struct {
-	TeamID   string    `json:"team_id"`
-	UserID   string    `json:"user_id"`
-	Role     string    `json:"role"`
-	JoinedAt time.Time `json:"joined_at"`
-	Status   string    `json:"status"`
+	OwnerID   string    `json:"owner_id"`
+	TeamID    string    `json:"team_id"`
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	InvitedBy string    `json:"invited_by,omitempty"`
+	JoinedAt  time.Time `json:"joined_at"`
+	Status    string    `json:"status"`
 }
 
+// 成员状态 邀请后为pending 被邀请人加入后为active
+const (
+	MemberStatusPending = "pending"
+	MemberStatusActive  = "active"
+	MemberStatusRemoved = "removed"
+)
+
 // 团队创建请求（值对象）
 type TeamCreateRequest struct {
 	Name        string `json:"name" binding:"required"`
 	Description string `json:"description,omitempty"`
 }
+
+// 成员邀请（值对象）
+type MemberInvite struct {
+	Email string
+	Role  string
+}
+
+// 团队访问信息（值对象）
+type TeamAccess = teamaccess.Access
@@ -1,38 +1,40 @@
 package domain
 
+import "context"
+
 type UserRepository interface {
 	// 基础 CRUD
-	FindByID(userID string) (*User, error)
-	FindByEmail(email string) (*User, error)
-	Create(user *User) (*User, error)
-	Update(user *User) (*User, error)
+	FindByID(ctx context.Context, userID string) (*User, error)
+	FindByEmail(ctx context.Context, email string) (*User, error)
+	Create(ctx context.Context, user *User) (*User, error)
+	Update(ctx context.Context, user *User) (*User, error)
 
 	// OAuth 相关
-	FindByOAuthID(provider, oauthID string) (*User, error)
-	UpdateLastLogin(userID string) error
+	FindByOAuthID(ctx context.Context, provider, oauthID string) (*User, error)
+	UpdateLastLogin(ctx context.Context, userID string) error
 
 	// 辅助方法
-	EmailExists(email string) (bool, error)
-	UsernameExists(username string) (bool, error)
-	GenerateUniqueUsername(preferred string) (string, error)
+	EmailExists(ctx context.Context, email string) (bool, error)
+	UsernameExists(ctx context.Context, username string) (bool, error)
+	GenerateUniqueUsername(ctx context.Context, preferred string) (string, error)
 }
 
 type TeamRepository interface {
+	// 团队权限
+	FindTeamAccess(ctx context.Context, teamID, userID string) (*TeamAccess, error)
+
 	// 团队 CRUD
-	CreateTeam(team *Team) (*Team, error)
-	FindTeamByID(teamID string) (*Team, error)
-	FindTeamsByOwner(ownerID string) ([]*Team, error)
-	UpdateTeam(team *Team) (*Team, error)
-
-	// 团队成员管理
-	AddTeamMember(member *TeamMember) error
-	RemoveTeamMember(teamID, userID string) error
-	FindTeamMembers(teamID string) ([]*TeamMember, error)
-	FindUserTeams(userID string) ([]*Team, error)
+	CreateTeam(ctx context.Context, team *Team) (*Team, error)
+	FindTeamByID(ctx context.Context, teamID string) (*Team, error)
+	FindUserTeams(ctx context.Context, userID string) ([]*Team, error)
+
+	// 团队成员管理 移除成员只修改状态
+	FindTeamMember(ctx context.Context, ownerID, teamID, userID string) (*TeamMember, error)
+	SaveTeamMember(ctx context.Context, member *TeamMember) error
 }
 
 type TokenCache interface {
-	GenRefreshToken(domain JwtPayload) (string, error)
-	ValidateRefreshToken(domain JwtPayload, refreshToken string) error
-	ResetRefreshTokenExpiry(domain JwtPayload) error
+	GenRefreshToken(ctx context.Context, domain JwtPayload) (string, error)
+	ValidateRefreshToken(ctx context.Context, domain JwtPayload, refreshToken string) error
+	ResetRefreshTokenExpiry(ctx context.Context, domain JwtPayload) error
 }
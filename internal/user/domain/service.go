@@ -1,25 +1,33 @@
 package domain
 
+import "context"
+
 // 纯业务逻辑，不依赖传输层
 type UserService interface {
-	AuthenticateWithOAuth(provider string, userInfo *OAuthUserInfo) (*User2Token, error)
-	RefreshUserToken(payload JwtPayload, refreshToken string) (*User2Token, error)
+	AuthenticateWithOAuth(ctx context.Context, provider string, userInfo *OAuthUserInfo) (*User2Token, error)
+	RefreshUserToken(ctx context.Context, payload JwtPayload, refreshToken string) (*User2Token, error)
 
-	GetUser(userID string) (*User, error)
-	UpdateUserProfile(userID string, updates *UserProfileUpdate) (*User, error)
+	GetUser(ctx context.Context, userID string) (*User, error)
+	UpdateUserProfile(ctx context.Context, userID string, updates *UserProfileUpdate) (*User, error)
 
 	// 团队管理（为微服务做准备）
-	CreateTeam(ownerID string, teamInfo *TeamCreateRequest) (*Team, error)
-	GetUserTeams(userID string) ([]*Team, error)
-	JoinTeam(userID, teamID string) error
+	CreateTeam(ctx context.Context, ownerID string, teamInfo *TeamCreateRequest) (*Team, error)
+	GetUserTeams(ctx context.Context, userID string) ([]*Team, error)
+	JoinTeam(ctx context.Context, userID, teamID string) error
+
+	// 成员管理 只有团队所有者和管理员可以操作 返回变更后的成员
+	// 团队相关事件统一在此发布 请求来源通过 eventbus.WithMeta 传入
+	InviteMember(ctx context.Context, actorID, teamID string, invite *MemberInvite) (*TeamMember, error)
+	ChangeMemberRole(ctx context.Context, actorID, teamID, userID, role string) (*TeamMember, error)
+	RemoveMember(ctx context.Context, actorID, teamID, userID string) (*TeamMember, error)
 }
 
 // 令牌服务接口
 type TokenService interface {
-	GenerateAccessToken(payload JwtPayload) (string, error)
-	ValidateAccessToken(token string) (payload JwtPayload, isExpire bool, err error)
-	RefreshAccessToken(domain JwtPayload, refreshToken string) (string, error)
+	GenerateAccessToken(ctx context.Context, payload JwtPayload) (string, error)
+	ValidateAccessToken(ctx context.Context, token string) (payload JwtPayload, isExpire bool, err error)
+	RefreshAccessToken(ctx context.Context, domain JwtPayload, refreshToken string) (string, error)
 
-	GenerateRefreshToken(payload JwtPayload) (string, error)
-	ResetRefreshTokenExpiry(domain JwtPayload) error
+	GenerateRefreshToken(ctx context.Context, payload JwtPayload) (string, error)
+	ResetRefreshTokenExpiry(ctx context.Context, domain JwtPayload) error
 }
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"google.golang.org/grpc"
+
+	teamv1 "sass-scaffold/api/protobuf/team/v1"
+	tokenv1 "sass-scaffold/api/protobuf/token/v1"
+	userv1 "sass-scaffold/api/protobuf/user/v1"
+	"sass-scaffold/internal/user/handler"
+)
+
+// GrpcPublicMethods 无需携带access_token的方法 令牌由请求体传入
+var GrpcPublicMethods = []string{
+	tokenv1.TokenService_ValidateAccessToken_FullMethodName,
+	tokenv1.TokenService_RefreshToken_FullMethodName,
+}
+
+func RegisterGrpcV1(s grpc.ServiceRegistrar, handler *handler.GrpcHandler) func() {
+	userv1.RegisterUserServiceServer(s, handler.UserServer())
+	teamv1.RegisterTeamServiceServer(s, handler.TeamServer())
+	tokenv1.RegisterTokenServiceServer(s, handler.TokenServer())
+	return nil
+}
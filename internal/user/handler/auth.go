@@ -1,10 +1,18 @@
 package handler
 
 import (
-	"os"
+	"context"
+	"sass-scaffold/internal/common/authguard"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/email"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
 	"sass-scaffold/internal/common/reskit/codes"
 	"sass-scaffold/internal/common/reskit/response"
+	"sass-scaffold/internal/common/tracing"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"resty.dev/v3"
@@ -13,12 +21,16 @@ import (
 )
 
 type HttpHandler struct {
-	userService domain.UserService
+	userService	domain.UserService
+	bus		*eventbus.Bus
+	github		config.GithubConfig
 }
 
-func NewHttpHandler(userService domain.UserService) *HttpHandler {
+func NewHttpHandler(userService domain.UserService, bus *eventbus.Bus, github config.GithubConfig) *HttpHandler {
 	return &HttpHandler{
-		userService: userService,
+		userService:	userService,
+		bus:		bus,
+		github:		github,
 	}
 }
 
@@ -29,21 +41,51 @@ func (h *HttpHandler) GithubAuth(ctx *gin.Context) {
 		return
 	}
 
+	// 同一IP连续失败后锁定 防止暴力尝试授权码
+	guardKeys := []string{authguard.IPKey(ctx.ClientIP())}
+	if !authguard.Guard(ctx, req.CaptchaToken, guardKeys...) {
+		return
+	}
+
 	// 1. 获取 GitHub 用户信息
-	userInfo, err := h.getGithubUserInfo(req.Code)
+	userInfo, err := h.getGithubUserInfo(ctx.Request.Context(), req.Code)
 	if err != nil {
+		authguard.Fail(ctx.Request.Context(), guardKeys...)
 		response.ValidationError(ctx, err)
 		return
 	}
 
+	// 换取到账号后再按邮箱计数 更换IP登录同一账号时同样受锁定限制
+	if userInfo.Email != "" {
+		guardKeys = append(guardKeys, authguard.AccountKey("email", strings.ToLower(userInfo.Email)))
+		if err := authguard.Check(ctx.Request.Context(), guardKeys...); err != nil {
+			response.Error(ctx, err)
+			return
+		}
+	}
+
+	// 新用户的通知语言取自请求头 之后可在个人资料中修改
+	userInfo.Locale = email.NormalizeLocale(ctx.GetHeader("Accept-Language"))
+
 	// 2. 调用业务逻辑
-	session, err := h.userService.AuthenticateWithOAuth("github", userInfo)
+	session, err := h.userService.AuthenticateWithOAuth(ctx.Request.Context(), "github", userInfo)
 	if err != nil {
+		authguard.Fail(ctx.Request.Context(), guardKeys...)
 		response.ValidationError(ctx, err)
 		return
 	}
 
-	// 3. 转换为响应格式
+	authguard.Succeed(ctx.Request.Context(), guardKeys...)
+
+	// 3. 记录登录事件
+	h.bus.Publish(ctx.Request.Context(), eventbus.UserLoggedIn{
+		UserID:		session.User.ID,
+		Provider:	"github",
+		Meta:		requestMeta(ctx),
+		OccurredAt:	time.Now(),
+	})
+
+	// 4. 转换为响应格式
 	res := Domain2TokenToAuthResponse(session)
 	response.Success(ctx, res)
 }
@@ -55,42 +97,68 @@ func (h *HttpHandler) RefreshToken(ctx *gin.Context) {
 		return
 	}
 
+	// 按IP和提交的刷新令牌计数 user_id未经验证 不能用于锁定账号
+	guardKeys := []string{authguard.IPKey(ctx.ClientIP()), authguard.CredentialKey("refresh_token", req.RefreshToken)}
+	if !authguard.Guard(ctx, req.CaptchaToken, guardKeys...) {
+		return
+	}
+
 	payload := domain.JwtPayload{
 		UserID:		req.UserID,
 		RandomCode:	req.RandomCode,
 	}
 
-	session, err := h.userService.RefreshUserToken(payload, req.RefreshToken)
+	session, err := h.userService.RefreshUserToken(ctx.Request.Context(), payload, req.RefreshToken)
 	if err != nil {
+		authguard.Fail(ctx.Request.Context(), guardKeys...)
 		response.Error(ctx, err)
 		return
 	}
+	authguard.Succeed(ctx.Request.Context(), guardKeys...)
+
+	h.bus.Publish(ctx.Request.Context(), eventbus.TokenRefreshed{
+		UserID:		req.UserID,
+		Meta:		requestMeta(ctx),
+		OccurredAt:	time.Now(),
+	})
 
 	res := DomainSessionToRefreshResponse(session)
 	response.Success(ctx, res)
 }
 
+// 认证中间件写入的用户ID
 func (h *HttpHandler) getUserID(ctx *gin.Context) (string, error) {
-	userID, exists := ctx.Get("user_id")
-	if !exists {
-		return "", codes.ErrTokenExpired
+	user, ok := auth.UserFromContext(ctx.Request.Context())
+	if !ok {
+		return "", codes.ErrUnauthorized
 	}
+	return user.ID, nil
+}
 
-	userIDStr, ok := userID.(string)
-	if !ok {
-		return "", codes.ErrTokenExpired
+// 请求来源信息 随事件一起发布供审计使用
+func requestMeta(ctx *gin.Context) eventbus.RequestMeta {
+	return eventbus.RequestMeta{
+		IP:		ctx.ClientIP(),
+		UserAgent:	ctx.Request.UserAgent(),
+		Country:	authguard.Country(ctx),
 	}
-	return userIDStr, nil
 }
 
+// eventContext 携带请求来源 供业务层发布事件
+func eventContext(ctx *gin.Context) context.Context {
+	return eventbus.WithMeta(ctx.Request.Context(), requestMeta(ctx))
+}
+
+const githubAPITimeout = 10 * time.Second
+
 // GitHub API 调用逻辑 - 返回包装好的领域错误
-func (h *HttpHandler) getGithubUserInfo(code string) (*domain.OAuthUserInfo, error) {
-	accessToken, err := h.getGithubAccessToken(code)
+func (h *HttpHandler) getGithubUserInfo(ctx context.Context, code string) (*domain.OAuthUserInfo, error) {
+	accessToken, err := h.getGithubAccessToken(ctx, code)
 	if err != nil {
 		return nil, codes.ErrGitHubAPIError.WithSlug("get_access_token 获取失败").WithCause(err)
 	}
 
-	userInfo, err := h.fetchGithubUserInfo(accessToken)
+	userInfo, err := h.fetchGithubUserInfo(ctx, accessToken)
 	if err != nil {
 		return nil, codes.ErrGitHubAPIError.WithSlug("get_user_info 获取失败").WithCause(err)
 	}
@@ -98,9 +166,9 @@ func (h *HttpHandler) getGithubUserInfo(code string) (*domain.OAuthUserInfo, err
 	return userInfo, nil
 }
 
-func (h *HttpHandler) getGithubAccessToken(code string) (string, error) {
-	clientID := os.Getenv("GITHUB_CLIENT_ID")
-	clientSecret := os.Getenv("GITHUB_CLIENT_SECRET")
+func (h *HttpHandler) getGithubAccessToken(ctx context.Context, code string) (string, error) {
+	clientID := h.github.ClientID
+	clientSecret := h.github.ClientSecret.Value()
 
 	if clientID == "" || clientSecret == "" {
 		return "", codes.ErrOAuthInvalidCode.WithDetail(map[string]any{
@@ -108,10 +176,11 @@ func (h *HttpHandler) getGithubAccessToken(code string) (string, error) {
 		})
 	}
 
-	client := resty.New()
+	client := resty.NewWithClient(tracing.NewHTTPClient(githubAPITimeout))
 	var result GithubAccessTokenResponse
 
 	_, err := client.R().
+		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetFormData(map[string]string{
 			"client_id":		clientID,
@@ -134,11 +203,12 @@ func (h *HttpHandler) getGithubAccessToken(code string) (string, error) {
 	return result.AccessToken, nil
 }
 
-func (h *HttpHandler) fetchGithubUserInfo(accessToken string) (*domain.OAuthUserInfo, error) {
-	client := resty.New()
+func (h *HttpHandler) fetchGithubUserInfo(ctx context.Context, accessToken string) (*domain.OAuthUserInfo, error) {
+	client := resty.NewWithClient(tracing.NewHTTPClient(githubAPITimeout))
 	var githubUser GithubUser
 
 	_, err := client.R().
+		SetContext(ctx).
 		SetHeader("Authorization", "Bearer "+accessToken).
 		SetHeader("Accept", "application/vnd.github+json").
 		SetResult(&githubUser).
@@ -2,16 +2,20 @@ package handler
 
 import (
 	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/eventbus"
 	"sass-scaffold/internal/common/reskit/response"
+	"sass-scaffold/internal/user/domain"
+	"time"
 )
 
 func (h *HttpHandler) GetProfile(ctx *gin.Context) {
 	userID, err := h.getUserID(ctx)
 	if err != nil {
 		response.Error(ctx, err)
+		return
 	}
 
-	user, err := h.userService.GetUser(userID)
+	user, err := h.userService.GetUser(ctx.Request.Context(), userID)
 	if err != nil {
 		response.Error(ctx, err)
 		return
@@ -25,6 +29,7 @@ func (h *HttpHandler) UpdateProfile(ctx *gin.Context) {
 	userID, err := h.getUserID(ctx)
 	if err != nil {
 		response.Error(ctx, err)
+		return
 	}
 
 	req := new(UserProfileUpdateRequest)
@@ -33,13 +38,47 @@ func (h *HttpHandler) UpdateProfile(ctx *gin.Context) {
 		return
 	}
 
+	// 保留更新前的资料用于审计
+	before, err := h.userService.GetUser(ctx.Request.Context(), userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
 	updates := HTTPUserUpdateToDomain(req)
-	user, err := h.userService.UpdateUserProfile(userID, updates)
+	user, err := h.userService.UpdateUserProfile(ctx.Request.Context(), userID, updates)
 	if err != nil {
 		response.Error(ctx, err)
 		return
 	}
 
+	if changes := profileChanges(before, user); len(changes) > 0 {
+		h.bus.Publish(ctx.Request.Context(), eventbus.ProfileUpdated{
+			UserID:		userID,
+			Changes:	changes,
+			Meta:		requestMeta(ctx),
+			OccurredAt:	time.Now(),
+		})
+	}
+
 	res := DomainUserToResponse(user)
 	response.Success(ctx, res)
 }
+
+// 对比可编辑的资料字段
+func profileChanges(before, after *domain.User) map[string]eventbus.Change {
+	changes := make(map[string]eventbus.Change)
+	if before.Name != after.Name {
+		changes["name"] = eventbus.Change{Before: before.Name, After: after.Name}
+	}
+	if before.Username != after.Username {
+		changes["username"] = eventbus.Change{Before: before.Username, After: after.Username}
+	}
+	if before.AvatarURL != after.AvatarURL {
+		changes["avatar_url"] = eventbus.Change{Before: before.AvatarURL, After: after.AvatarURL}
+	}
+	if before.Locale != after.Locale {
+		changes["locale"] = eventbus.Change{Before: before.Locale, After: after.Locale}
+	}
+	return changes
+}
@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	teamv1 "sass-scaffold/api/protobuf/team/v1"
+	tokenv1 "sass-scaffold/api/protobuf/token/v1"
+	userv1 "sass-scaffold/api/protobuf/user/v1"
+	"sass-scaffold/internal/common/authguard"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/user/domain"
+)
+
+// GrpcHandler gRPC接口 与HttpHandler共用业务服务 错误由服务端拦截器统一转换
+type GrpcHandler struct {
+	userService  domain.UserService
+	tokenService domain.TokenService
+	bus          *eventbus.Bus
+}
+
+func NewGrpcHandler(userService domain.UserService, tokenService domain.TokenService, bus *eventbus.Bus) *GrpcHandler {
+	return &GrpcHandler{
+		userService:  userService,
+		tokenService: tokenService,
+		bus:          bus,
+	}
+}
+
+// UserServer 实现userv1.UserServiceServer
+func (h *GrpcHandler) UserServer() userv1.UserServiceServer {
+	return &userServer{h: h}
+}
+
+// TeamServer 实现teamv1.TeamServiceServer
+func (h *GrpcHandler) TeamServer() teamv1.TeamServiceServer {
+	return &teamServer{h: h}
+}
+
+// TokenServer 实现tokenv1.TokenServiceServer
+func (h *GrpcHandler) TokenServer() tokenv1.TokenServiceServer {
+	return &tokenServer{h: h}
+}
+
+type userServer struct {
+	userv1.UnimplementedUserServiceServer
+	h *GrpcHandler
+}
+
+func (s *userServer) GetProfile(ctx context.Context, _ *userv1.GetProfileRequest) (*userv1.User, error) {
+	userID, err := grpcUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.h.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return DomainUserToProto(user), nil
+}
+
+func (s *userServer) UpdateProfile(ctx context.Context, req *userv1.UpdateProfileRequest) (*userv1.User, error) {
+	userID, err := grpcUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 保留更新前的资料用于审计
+	before, err := s.h.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.h.userService.UpdateUserProfile(ctx, userID, &domain.UserProfileUpdate{
+		Name:     req.Name,
+		Username: req.Username,
+		Avatar:   req.Avatar,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changes := profileChanges(before, user); len(changes) > 0 {
+		s.h.bus.Publish(ctx, eventbus.ProfileUpdated{
+			UserID:     userID,
+			Changes:    changes,
+			Meta:       grpcRequestMeta(ctx),
+			OccurredAt: time.Now(),
+		})
+	}
+	return DomainUserToProto(user), nil
+}
+
+type teamServer struct {
+	teamv1.UnimplementedTeamServiceServer
+	h *GrpcHandler
+}
+
+func (s *teamServer) CreateTeam(ctx context.Context, req *teamv1.CreateTeamRequest) (*teamv1.Team, error) {
+	userID, err := grpcUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, requiredField("name")
+	}
+
+	team, err := s.h.userService.CreateTeam(ctx, userID, &domain.TeamCreateRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DomainTeamToProto(team), nil
+}
+
+func (s *teamServer) ListTeams(ctx context.Context, _ *teamv1.ListTeamsRequest) (*teamv1.ListTeamsResponse, error) {
+	userID, err := grpcUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.h.userService.GetUserTeams(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &teamv1.ListTeamsResponse{Teams: make([]*teamv1.Team, 0, len(teams))}
+	for _, team := range teams {
+		res.Teams = append(res.Teams, DomainTeamToProto(team))
+	}
+	return res, nil
+}
+
+func (s *teamServer) JoinTeam(ctx context.Context, req *teamv1.JoinTeamRequest) (*teamv1.JoinTeamResponse, error) {
+	userID, err := grpcUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTeamId() == "" {
+		return nil, requiredField("team_id")
+	}
+
+	if err := s.h.userService.JoinTeam(eventbus.WithMeta(ctx, grpcRequestMeta(ctx)), userID, req.GetTeamId()); err != nil {
+		return nil, err
+	}
+	return &teamv1.JoinTeamResponse{}, nil
+}
+
+type tokenServer struct {
+	tokenv1.UnimplementedTokenServiceServer
+	h *GrpcHandler
+}
+
+func (s *tokenServer) ValidateAccessToken(ctx context.Context, req *tokenv1.ValidateAccessTokenRequest) (*tokenv1.ValidateAccessTokenResponse, error) {
+	if req.GetAccessToken() == "" {
+		return nil, requiredField("access_token")
+	}
+
+	payload, isExpire, err := s.h.tokenService.ValidateAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		if isExpire {
+			return nil, codes.ErrTokenExpired
+		}
+		return nil, codes.ErrTokenInvalid
+	}
+
+	return &tokenv1.ValidateAccessTokenResponse{
+		UserId:     payload.UserID,
+		RandomCode: payload.RandomCode,
+	}, nil
+}
+
+func (s *tokenServer) RefreshToken(ctx context.Context, req *tokenv1.RefreshTokenRequest) (*tokenv1.RefreshTokenResponse, error) {
+	switch {
+	case req.GetUserId() == "":
+		return nil, requiredField("user_id")
+	case req.GetRandomCode() == "":
+		return nil, requiredField("random_code")
+	case req.GetRefreshToken() == "":
+		return nil, requiredField("refresh_token")
+	}
+
+	// 与HTTP共用失败计数 按对端地址和提交的刷新令牌计数 user_id未经验证 不能用于锁定账号
+	// gRPC无法完成验证码 只检查锁定
+	meta := grpcRequestMeta(ctx)
+	guardKeys := []string{authguard.CredentialKey("refresh_token", req.GetRefreshToken())}
+	if meta.IP != "" {
+		guardKeys = append(guardKeys, authguard.IPKey(meta.IP))
+	}
+	if err := authguard.Check(ctx, guardKeys...); err != nil {
+		return nil, err
+	}
+
+	payload := domain.JwtPayload{
+		UserID:     req.GetUserId(),
+		RandomCode: req.GetRandomCode(),
+	}
+	session, err := s.h.userService.RefreshUserToken(ctx, payload, req.GetRefreshToken())
+	if err != nil {
+		authguard.Fail(ctx, guardKeys...)
+		return nil, err
+	}
+	authguard.Succeed(ctx, guardKeys...)
+
+	s.h.bus.Publish(ctx, eventbus.TokenRefreshed{
+		UserID:     req.GetUserId(),
+		Meta:       meta,
+		OccurredAt: time.Now(),
+	})
+
+	return &tokenv1.RefreshTokenResponse{
+		AccessToken:  session.AccessToken,
+		RefreshToken: session.RefreshToken,
+	}, nil
+}
+
+// 认证拦截器写入的用户ID
+func grpcUserID(ctx context.Context) (string, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return "", codes.ErrUnauthorized
+	}
+	return user.ID, nil
+}
+
+func requiredField(field string) error {
+	return codes.ErrValidationFailed.WithDetail(map[string]any{field: "required"})
+}
+
+// gRPC请求来源信息 经过代理时为代理地址
+func grpcRequestMeta(ctx context.Context) eventbus.RequestMeta {
+	var meta eventbus.RequestMeta
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		meta.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(meta.IP); err == nil {
+			meta.IP = host
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("user-agent"); len(values) > 0 {
+			meta.UserAgent = values[0]
+		}
+	}
+	return meta
+}
+
+// 转换函数
+func DomainUserToProto(user *domain.User) *userv1.User {
+	if user == nil {
+		return nil
+	}
+
+	res := &userv1.User{
+		Id:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Username:      user.Username,
+		AvatarUrl:     user.AvatarURL,
+		EmailVerified: user.EmailVerified,
+		Status:        user.Status,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+	}
+	if user.LastLoginAt != nil {
+		res.LastLoginAt = timestamppb.New(*user.LastLoginAt)
+	}
+	return res
+}
+
+func DomainTeamToProto(team *domain.Team) *teamv1.Team {
+	if team == nil {
+		return nil
+	}
+
+	return &teamv1.Team{
+		Id:          team.ID,
+		OwnerId:     team.OwnerID,
+		Name:        team.Name,
+		Description: team.Description,
+		Status:      team.Status,
+		CreatedAt:   timestamppb.New(team.CreatedAt),
+		UpdatedAt:   timestamppb.New(team.UpdatedAt),
+	}
+}
@@ -8,19 +8,22 @@ import (
 
 // HTTP 请求/响应模型
 type GithubAuthRequest struct {
-	Code string `json:"code" binding:"required"`
+	Code		string	`json:"code" binding:"required"`
+	CaptchaToken	string	`json:"captcha_token"`
 }
 
 type RefreshTokenRequest struct {
 	UserID		string	`json:"user_id" binding:"required"`
 	RandomCode	string	`json:"random_code" binding:"required"`
 	RefreshToken	string	`json:"refresh_token" binding:"required"`
+	CaptchaToken	string	`json:"captcha_token"`
 }
 
 type UserProfileUpdateRequest struct {
 	Name		*string	`json:"name,omitempty"`
 	Username	*string	`json:"username,omitempty"`
 	Avatar		*string	`json:"avatar,omitempty"`
+	Locale		*string	`json:"locale,omitempty" binding:"omitempty,oneof=zh en"`
 }
 
 type UserResponse struct {
@@ -31,6 +34,7 @@ type UserResponse struct {
 	AvatarURL	string		`json:"avatar_url,omitempty"`
 	EmailVerified	bool		`json:"email_verified"`
 	Status		string		`json:"status"`
+	Locale		string		`json:"locale"`
 	CreatedAt	time.Time	`json:"created_at"`
 	UpdatedAt	time.Time	`json:"updated_at"`
 	LastLoginAt	*time.Time	`json:"last_login_at,omitempty"`
@@ -47,6 +51,39 @@ type RefreshTokenResponse struct {
 	RefreshToken	string	`json:"refresh_token"`
 }
 
+type TeamCreateRequest struct {
+	Name		string	`json:"name" binding:"required,max=255"`
+	Description	string	`json:"description,omitempty" binding:"max=1000"`
+}
+
+type MemberInviteRequest struct {
+	Email	string	`json:"email" binding:"required,email"`
+	Role	string	`json:"role" binding:"required,oneof=admin member"`
+}
+
+type MemberRoleUpdateRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin member"`
+}
+
+type TeamResponse struct {
+	ID		string		`json:"id"`
+	OwnerID		string		`json:"owner_id"`
+	Name		string		`json:"name"`
+	Description	string		`json:"description,omitempty"`
+	Status		string		`json:"status"`
+	CreatedAt	time.Time	`json:"created_at"`
+	UpdatedAt	time.Time	`json:"updated_at"`
+}
+
+type TeamMemberResponse struct {
+	TeamID		string		`json:"team_id"`
+	UserID		string		`json:"user_id"`
+	Role		string		`json:"role"`
+	InvitedBy	string		`json:"invited_by,omitempty"`
+	Status		string		`json:"status"`
+	JoinedAt	time.Time	`json:"joined_at"`
+}
+
 // GitHub API 响应模型
 type GithubUser struct {
 	ID		int64	`json:"id"`
@@ -76,6 +113,7 @@ func DomainUserToResponse(user *domain.User) *UserResponse {
 		AvatarURL:	user.AvatarURL,
 		EmailVerified:	user.EmailVerified,
 		Status:		user.Status,
+		Locale:		user.Locale,
 		CreatedAt:	user.CreatedAt,
 		UpdatedAt:	user.UpdatedAt,
 		LastLoginAt:	user.LastLoginAt,
@@ -84,6 +122,7 @@ func DomainUserToResponse(user *domain.User) *UserResponse {
 
 func Domain2TokenToAuthResponse(token2 *domain.User2Token) *AuthResponse {
 	return &AuthResponse{
+		User:		DomainUserToResponse(token2.User),
 		AccessToken:	token2.AccessToken,
 		RefreshToken:	token2.RefreshToken,
 	}
@@ -101,5 +140,37 @@ func HTTPUserUpdateToDomain(req *UserProfileUpdateRequest) *domain.UserProfileUp
 		Name:		req.Name,
 		Username:	req.Username,
 		Avatar:		req.Avatar,
+		Locale:		req.Locale,
+	}
+}
+
+func DomainTeamToResponse(team *domain.Team) *TeamResponse {
+	if team == nil {
+		return nil
+	}
+
+	return &TeamResponse{
+		ID:		team.ID,
+		OwnerID:	team.OwnerID,
+		Name:		team.Name,
+		Description:	team.Description,
+		Status:		team.Status,
+		CreatedAt:	team.CreatedAt,
+		UpdatedAt:	team.UpdatedAt,
+	}
+}
+
+func DomainMemberToResponse(member *domain.TeamMember) *TeamMemberResponse {
+	if member == nil {
+		return nil
+	}
+
+	return &TeamMemberResponse{
+		TeamID:		member.TeamID,
+		UserID:		member.UserID,
+		Role:		member.Role,
+		InvitedBy:	member.InvitedBy,
+		Status:		member.Status,
+		JoinedAt:	member.JoinedAt,
 	}
 }
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/common/reskit/response"
+	"sass-scaffold/internal/user/domain"
+)
+
+func (h *HttpHandler) CreateTeam(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(TeamCreateRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	team, err := h.userService.CreateTeam(ctx.Request.Context(), userID, &domain.TeamCreateRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainTeamToResponse(team))
+}
+
+func (h *HttpHandler) ListTeams(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	teams, err := h.userService.GetUserTeams(ctx.Request.Context(), userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	res := make([]*TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		res = append(res, DomainTeamToResponse(team))
+	}
+	response.Success(ctx, res)
+}
+
+func (h *HttpHandler) JoinTeam(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.userService.JoinTeam(eventContext(ctx), userID, ctx.Param("id")); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, nil)
+}
+
+func (h *HttpHandler) InviteMember(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MemberInviteRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	member, err := h.userService.InviteMember(eventContext(ctx), userID, ctx.Param("id"), &domain.MemberInvite{
+		Email: req.Email,
+		Role:  req.Role,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainMemberToResponse(member))
+}
+
+func (h *HttpHandler) UpdateMemberRole(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MemberRoleUpdateRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	member, err := h.userService.ChangeMemberRole(eventContext(ctx), userID, ctx.Param("id"), ctx.Param("user_id"), req.Role)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainMemberToResponse(member))
+}
+
+func (h *HttpHandler) RemoveMember(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if _, err := h.userService.RemoveMember(eventContext(ctx), userID, ctx.Param("id"), ctx.Param("user_id")); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, nil)
+}
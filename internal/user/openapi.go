@@ -0,0 +1,121 @@
+package user
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/user/handler"
+)
+
+// 接口文档 修改router_v1.go中的路由时同步修改
+func init() {
+	guardErrors := []codes.ErrCode{codes.ErrRateLimitExceeded, codes.ErrAuthLocked, codes.ErrCaptchaRequired, codes.ErrCaptchaInvalid}
+	teamErrors := []codes.ErrCode{codes.ErrTeamNotFound, codes.ErrTeamPermissionDenied, codes.ErrRateLimitExceeded}
+
+	openapi.Register(
+		openapi.Operation{
+			Method:   http.MethodPost,
+			Path:     "/v1/user/auth/github",
+			Summary:  "GitHub登录",
+			Tags:     []string{"user"},
+			Request:  handler.GithubAuthRequest{},
+			Response: handler.AuthResponse{},
+			Errors:   append([]codes.ErrCode{codes.ErrOAuthInvalidCode, codes.ErrGitHubAPIError}, guardErrors...),
+		},
+		openapi.Operation{
+			Method:   http.MethodPost,
+			Path:     "/v1/user/refresh_token",
+			Summary:  "刷新访问令牌",
+			Tags:     []string{"user"},
+			Request:  handler.RefreshTokenRequest{},
+			Response: handler.RefreshTokenResponse{},
+			Errors:   append([]codes.ErrCode{codes.ErrRefreshTokenInvalid, codes.ErrRefreshTokenExpired}, guardErrors...),
+		},
+		openapi.Operation{
+			Method:  http.MethodPost,
+			Path:    "/v1/user/auth",
+			Summary: "校验访问令牌",
+			Tags:    []string{"user"},
+			Auth:    true,
+			Errors:  []codes.ErrCode{codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:   http.MethodGet,
+			Path:     "/v1/user/profile",
+			Summary:  "获取个人资料",
+			Tags:     []string{"user"},
+			Auth:     true,
+			Response: handler.UserResponse{},
+			Errors:   []codes.ErrCode{codes.ErrUserNotFound, codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:   http.MethodPut,
+			Path:     "/v1/user/profile",
+			Summary:  "更新个人资料",
+			Tags:     []string{"user"},
+			Auth:     true,
+			Request:  handler.UserProfileUpdateRequest{},
+			Response: handler.UserResponse{},
+			Errors:   []codes.ErrCode{codes.ErrUserNotFound, codes.ErrUsernameAlreadyExists, codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:   http.MethodPost,
+			Path:     "/v1/teams",
+			Summary:  "创建团队",
+			Tags:     []string{"team"},
+			Auth:     true,
+			Request:  handler.TeamCreateRequest{},
+			Response: handler.TeamResponse{},
+			Errors:   []codes.ErrCode{codes.ErrTeamAlreadyExists, codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:   http.MethodGet,
+			Path:     "/v1/teams",
+			Summary:  "我的团队",
+			Tags:     []string{"team"},
+			Auth:     true,
+			Response: []*handler.TeamResponse{},
+			Errors:   []codes.ErrCode{codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:      http.MethodPost,
+			Path:        "/v1/teams/:id/join",
+			Summary:     "接受团队邀请",
+			Description: "只有被邀请的用户可以加入",
+			Tags:        []string{"team"},
+			Auth:        true,
+			Errors:      []codes.ErrCode{codes.ErrTeamNotFound, codes.ErrTeamMemberNotFound, codes.ErrRateLimitExceeded},
+		},
+		openapi.Operation{
+			Method:      http.MethodPost,
+			Path:        "/v1/teams/:id/members",
+			Summary:     "邀请成员",
+			Description: "只有团队所有者可以邀请管理员",
+			Tags:        []string{"team"},
+			Auth:        true,
+			Request:     handler.MemberInviteRequest{},
+			Response:    handler.TeamMemberResponse{},
+			Errors:      append([]codes.ErrCode{codes.ErrUserNotFound, codes.ErrTeamMemberExists}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:      http.MethodPatch,
+			Path:        "/v1/teams/:id/members/:user_id",
+			Summary:     "修改成员角色",
+			Description: "只有团队所有者可以授予或撤销管理员角色",
+			Tags:        []string{"team"},
+			Auth:        true,
+			Request:     handler.MemberRoleUpdateRequest{},
+			Response:    handler.TeamMemberResponse{},
+			Errors:      append([]codes.ErrCode{codes.ErrTeamMemberNotFound, codes.ErrTeamOwnerImmutable}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:  http.MethodDelete,
+			Path:    "/v1/teams/:id/members/:user_id",
+			Summary: "移除成员",
+			Tags:    []string{"team"},
+			Auth:    true,
+			Errors:  append([]codes.ErrCode{codes.ErrTeamMemberNotFound, codes.ErrTeamOwnerImmutable}, teamErrors...),
+		},
+	)
+}
@@ -3,25 +3,41 @@ package user
 import (
 	"github.com/gin-gonic/gin"
 	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/ratelimit"
 	"sass-scaffold/internal/user/handler"
 )
 
-func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler, authMiddleware *auth.Middleware) func() {
 	userGroup := r.Group("/v1/user")
 
 	{
-		// 认证相关路由
-		userGroup.POST("/auth/github", handler.GithubAuth)
-		userGroup.POST("/refresh_token", handler.RefreshToken)
+		// 认证相关路由 未登录按IP限流
+		public := userGroup.Group("")
+		public.Use(ratelimit.PerIP())
+		{
+			public.POST("/auth/github", handler.GithubAuth)
+			public.POST("/refresh_token", handler.RefreshToken)
+		}
 
 		// 需要token的路由
 		protected := userGroup.Group("")
-		protected.Use(auth.Validate())
+		protected.Use(authMiddleware.Validate(), ratelimit.PerUser())
 		{
 			protected.POST("/auth")
 			protected.GET("/profile", handler.GetProfile)
 			protected.PUT("/profile", handler.UpdateProfile)
 		}
 	}
+
+	teamGroup := r.Group("/v1/teams")
+	teamGroup.Use(authMiddleware.Validate(), ratelimit.PerUser())
+	{
+		teamGroup.POST("", handler.CreateTeam)
+		teamGroup.GET("", handler.ListTeams)
+		teamGroup.POST("/:id/join", handler.JoinTeam)
+		teamGroup.POST("/:id/members", handler.InviteMember)
+		teamGroup.PATCH("/:id/members/:user_id", handler.UpdateMemberRole)
+		teamGroup.DELETE("/:id/members/:user_id", handler.RemoveMember)
+	}
 	return nil
 }
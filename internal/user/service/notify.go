@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"sass-scaffold/internal/common/authguard"
+	"sass-scaffold/internal/common/email"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/user/domain"
+)
+
+const (
+	welcomeTemplate    = "welcome"
+	teamInviteTemplate = "team_invite"
+)
+
+// Notifier 注册与邀请成功后给用户发送通知邮件 邮件服务未初始化时跳过
+// 同时为公共模块提供收件人查询
+type Notifier struct {
+	userRepo domain.UserRepository
+	teamRepo domain.TeamRepository
+}
+
+func NewNotifier(userRepo domain.UserRepository, teamRepo domain.TeamRepository) *Notifier {
+	return &Notifier{
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+// Subscribe 订阅相关事件 只应调用一次 否则会重复发送
+func (s *Notifier) Subscribe(bus *eventbus.Bus) {
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.UserRegistered) error {
+		return s.sendWelcome(ctx, e)
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberInvited) error {
+		return s.sendTeamInvite(ctx, e)
+	})
+}
+
+// LoginRecipient 供authguard发送新设备登录提醒
+func (s *Notifier) LoginRecipient(ctx context.Context, userID string) (authguard.Recipient, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return authguard.Recipient{}, err
+	}
+	return authguard.Recipient{
+		Email:  user.Email,
+		Name:   user.Name,
+		Locale: user.Locale,
+	}, nil
+}
+
+func (s *Notifier) sendWelcome(ctx context.Context, e eventbus.UserRegistered) error {
+	mailer := email.GetMailerInstance()
+	if mailer == nil || e.Email == "" {
+		return nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, e.UserID)
+	if err != nil {
+		return errors.WithMessage(err, "查询用户失败")
+	}
+	return mailer.SendTemplate(user.Email, welcomeTemplate, user.Locale, map[string]any{
+		"Name":         user.Name,
+		"DashboardURL": email.Link("/dashboard"),
+	})
+}
+
+func (s *Notifier) sendTeamInvite(ctx context.Context, e eventbus.MemberInvited) error {
+	mailer := email.GetMailerInstance()
+	if mailer == nil {
+		return nil
+	}
+
+	invitee, err := s.userRepo.FindByID(ctx, e.UserID)
+	if err != nil {
+		return errors.WithMessage(err, "查询被邀请用户失败")
+	}
+	if invitee.Email == "" {
+		return nil
+	}
+	inviter, err := s.userRepo.FindByID(ctx, e.InvitedBy)
+	if err != nil {
+		return errors.WithMessage(err, "查询邀请人失败")
+	}
+	team, err := s.teamRepo.FindTeamByID(ctx, e.TeamID)
+	if err != nil {
+		return errors.WithMessage(err, "查询团队失败")
+	}
+	return mailer.SendTemplate(invitee.Email, teamInviteTemplate, invitee.Locale, map[string]any{
+		"InviterName": inviter.Name,
+		"TeamName":    team.Name,
+		"Role":        e.Role,
+		"AcceptURL":   email.Link("/teams/" + e.TeamID + "/join"),
+	})
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/teamaccess"
+	"sass-scaffold/internal/common/utils"
+	"sass-scaffold/internal/user/domain"
+)
+
+func (s *userService) CreateTeam(ctx context.Context, ownerID string, teamInfo *domain.TeamCreateRequest) (*domain.Team, error) {
+	team, err := s.teamRepo.CreateTeam(ctx, &domain.Team{
+		OwnerID:     ownerID,
+		Name:        teamInfo.Name,
+		Description: teamInfo.Description,
+		Status:      "active",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, eventbus.TeamCreated{
+		TeamID:     team.ID,
+		OwnerID:    team.OwnerID,
+		Name:       team.Name,
+		OccurredAt: time.Now(),
+	})
+	return team, nil
+}
+
+func (s *userService) GetUserTeams(ctx context.Context, userID string) ([]*domain.Team, error) {
+	return s.teamRepo.FindUserTeams(ctx, userID)
+}
+
+// JoinTeam 接受邀请 只有待加入的成员可以加入
+func (s *userService) JoinTeam(ctx context.Context, userID, teamID string) error {
+	team, err := s.findTeam(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.teamRepo.FindTeamMember(ctx, team.OwnerID, teamID, userID)
+	if err != nil {
+		return err
+	}
+	switch member.Status {
+	case domain.MemberStatusActive:
+		return nil
+	case domain.MemberStatusPending:
+	default:
+		return codes.ErrTeamMemberNotFound
+	}
+
+	member.Status = domain.MemberStatusActive
+	member.JoinedAt = time.Now()
+	if err := s.teamRepo.SaveTeamMember(ctx, member); err != nil {
+		return err
+	}
+
+	s.bus.Publish(ctx, eventbus.MemberJoined{
+		TeamID:     member.TeamID,
+		OwnerID:    member.OwnerID,
+		UserID:     member.UserID,
+		Role:       member.Role,
+		Meta:       eventbus.MetaFrom(ctx),
+		OccurredAt: time.Now(),
+	})
+	return nil
+}
+
+func (s *userService) InviteMember(ctx context.Context, actorID, teamID string, invite *domain.MemberInvite) (*domain.TeamMember, error) {
+	access, err := s.checkTeamAdmin(ctx, actorID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if invite.Role == teamaccess.RoleAdmin && access.Role != teamaccess.RoleOwner {
+		return nil, codes.ErrTeamPermissionDenied
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, invite.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == access.OwnerID {
+		return nil, codes.ErrTeamMemberExists
+	}
+
+	existing, err := s.teamRepo.FindTeamMember(ctx, access.OwnerID, teamID, user.ID)
+	switch {
+	case err == nil && existing.Status != domain.MemberStatusRemoved:
+		return nil, codes.ErrTeamMemberExists
+	case err != nil && !errors.Is(err, codes.ErrTeamMemberNotFound):
+		return nil, err
+	}
+
+	member := &domain.TeamMember{
+		OwnerID:   access.OwnerID,
+		TeamID:    teamID,
+		UserID:    user.ID,
+		Role:      invite.Role,
+		InvitedBy: actorID,
+		JoinedAt:  time.Now(),
+		Status:    domain.MemberStatusPending,
+	}
+	if err := s.teamRepo.SaveTeamMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, eventbus.MemberInvited{
+		TeamID:     member.TeamID,
+		OwnerID:    member.OwnerID,
+		UserID:     member.UserID,
+		Role:       member.Role,
+		InvitedBy:  actorID,
+		Meta:       eventbus.MetaFrom(ctx),
+		OccurredAt: time.Now(),
+	})
+	return member, nil
+}
+
+func (s *userService) ChangeMemberRole(ctx context.Context, actorID, teamID, userID, role string) (*domain.TeamMember, error) {
+	access, member, err := s.findManagedMember(ctx, actorID, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == teamaccess.RoleAdmin && access.Role != teamaccess.RoleOwner {
+		return nil, codes.ErrTeamPermissionDenied
+	}
+
+	oldRole := member.Role
+	if oldRole == role {
+		return member, nil
+	}
+
+	member.Role = role
+	if err := s.teamRepo.SaveTeamMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, eventbus.MemberRoleChanged{
+		TeamID:     member.TeamID,
+		OwnerID:    member.OwnerID,
+		UserID:     member.UserID,
+		OldRole:    oldRole,
+		NewRole:    member.Role,
+		ChangedBy:  actorID,
+		Meta:       eventbus.MetaFrom(ctx),
+		OccurredAt: time.Now(),
+	})
+	return member, nil
+}
+
+func (s *userService) RemoveMember(ctx context.Context, actorID, teamID, userID string) (*domain.TeamMember, error) {
+	_, member, err := s.findManagedMember(ctx, actorID, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Status = domain.MemberStatusRemoved
+	if err := s.teamRepo.SaveTeamMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, eventbus.MemberRemoved{
+		TeamID:     member.TeamID,
+		OwnerID:    member.OwnerID,
+		UserID:     member.UserID,
+		RemovedBy:  actorID,
+		Meta:       eventbus.MetaFrom(ctx),
+		OccurredAt: time.Now(),
+	})
+	return member, nil
+}
+
+// 私有辅助方法
+func (s *userService) findTeam(ctx context.Context, teamID string) (*domain.Team, error) {
+	if !utils.IsUUID(teamID) {
+		return nil, codes.ErrTeamNotFound
+	}
+	return s.teamRepo.FindTeamByID(ctx, teamID)
+}
+
+func (s *userService) checkTeamAdmin(ctx context.Context, userID, teamID string) (*domain.TeamAccess, error) {
+	access, err := s.teamRepo.FindTeamAccess(ctx, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.IsAdmin() {
+		return nil, codes.ErrTeamPermissionDenied
+	}
+	return access, nil
+}
+
+// 所有者没有成员记录 不能被修改 管理员只能管理普通成员
+func (s *userService) findManagedMember(ctx context.Context, actorID, teamID, userID string) (*domain.TeamAccess, *domain.TeamMember, error) {
+	access, err := s.checkTeamAdmin(ctx, actorID, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if userID == access.OwnerID {
+		return nil, nil, codes.ErrTeamOwnerImmutable
+	}
+	if !utils.IsUUID(userID) {
+		return nil, nil, codes.ErrTeamMemberNotFound
+	}
+
+	member, err := s.teamRepo.FindTeamMember(ctx, access.OwnerID, teamID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if member.Status == domain.MemberStatusRemoved {
+		return nil, nil, codes.ErrTeamMemberNotFound
+	}
+	if access.Role != teamaccess.RoleOwner && member.Role == teamaccess.RoleAdmin {
+		return nil, nil, codes.ErrTeamPermissionDenied
+	}
+	return access, member, nil
+}
@@ -1,54 +1,41 @@
 package service
 
 import (
-	"github.com/joho/godotenv"
+	"context"
 	"github.com/pkg/errors"
-	"os"
+	"sass-scaffold/internal/common/config"
 	"sass-scaffold/internal/common/jwt"
 	"sass-scaffold/internal/common/utils"
 	"sass-scaffold/internal/user/domain"
-	"strconv"
-	"time"
 )
 
-var (
-	secret	string
-	expire	time.Duration
-)
-
-func init() {
-	_ = godotenv.Load()
-	secret = os.Getenv("JWT_SECRET")
-	expireMinuteStr := os.Getenv("JWT_EXPIRE_MINUTE")
-	if secret == "" || expireMinuteStr == "" {
-		panic("加载环境变量失败")
-	}
-	expireMinute, err := strconv.Atoi(expireMinuteStr)
-	if err != nil {
-		panic(err)
-	}
-	expire = time.Minute * time.Duration(expireMinute)
-}
-
 type tokenService struct {
 	tokenCache	domain.TokenCache
 	userRepo	domain.UserRepository
+	config		config.Source
 }
 
-func NewTokenService(tokenCache domain.TokenCache, userRepo domain.UserRepository) domain.TokenService {
+func NewTokenService(tokenCache domain.TokenCache, userRepo domain.UserRepository, source config.Source) domain.TokenService {
 	return &tokenService{
 		tokenCache:	tokenCache,
 		userRepo:	userRepo,
+		config:		source,
 	}
 }
 
-func (t tokenService) GenerateAccessToken(payload domain.JwtPayload) (string, error) {
-	token, err := jwt.GenToken[domain.JwtPayload](payload, secret, expire)
+// 每次读取最新配置 热加载替换密钥后已签发的令牌可通过PreviousSecrets继续校验
+func (t *tokenService) jwtConfig() *config.JWTConfig {
+	return &t.config().JWT
+}
+
+func (t *tokenService) GenerateAccessToken(ctx context.Context, payload domain.JwtPayload) (string, error) {
+	cfg := t.jwtConfig()
+	token, err := jwt.GenToken[domain.JwtPayload](payload, cfg.Secret.Value(), cfg.Expire())
 	return token, errors.WithStack(err)
 }
 
-func (t tokenService) ValidateAccessToken(token string) (claim domain.JwtPayload, isExpire bool, err error) {
-	claims, err := jwt.ParseToken[domain.JwtPayload](token, secret)
+func (t *tokenService) ValidateAccessToken(ctx context.Context, token string) (claim domain.JwtPayload, isExpire bool, err error) {
+	claims, err := jwt.ParseTokenWithKeys[domain.JwtPayload](token, t.jwtConfig().VerifyKeys())
 	if err != nil {
 		switch {
 		case errors.Is(err, jwt.ErrTokenExpired):
@@ -58,15 +45,23 @@ func (t tokenService) ValidateAccessToken(token string) (claim domain.JwtPayload
 		}
 	}
 
-	return claims.PayLoad, false, nil
+	payload := claims.PayLoad
+	payload.Issuer = claims.Issuer
+	if claims.IssuedAt != nil {
+		payload.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		payload.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return payload, false, nil
 }
 
-func (t tokenService) RefreshAccessToken(payload domain.JwtPayload, refreshToken string) (string, error) {
-	if err := t.tokenCache.ValidateRefreshToken(payload, refreshToken); err != nil {
+func (t *tokenService) RefreshAccessToken(ctx context.Context, payload domain.JwtPayload, refreshToken string) (string, error) {
+	if err := t.tokenCache.ValidateRefreshToken(ctx, payload, refreshToken); err != nil {
 		return "", err
 	}
 	// 为后续扩展jwt字段保留空间
-	user, err := t.userRepo.FindByID(payload.UserID)
+	user, err := t.userRepo.FindByID(ctx, payload.UserID)
 	if err != nil {
 		return "", err
 	}
@@ -74,13 +69,13 @@ func (t tokenService) RefreshAccessToken(payload domain.JwtPayload, refreshToken
 		UserID:		user.ID,
 		RandomCode:	utils.GenRandomCodeForJWT(),
 	}
-	return t.GenerateAccessToken(newPayload)
+	return t.GenerateAccessToken(ctx, newPayload)
 }
 
-func (t tokenService) GenerateRefreshToken(payload domain.JwtPayload) (string, error) {
-	return t.tokenCache.GenRefreshToken(payload)
+func (t *tokenService) GenerateRefreshToken(ctx context.Context, payload domain.JwtPayload) (string, error) {
+	return t.tokenCache.GenRefreshToken(ctx, payload)
 }
 
-func (t tokenService) ResetRefreshTokenExpiry(payload domain.JwtPayload) error {
-	return t.tokenCache.ResetRefreshTokenExpiry(payload)
+func (t *tokenService) ResetRefreshTokenExpiry(ctx context.Context, payload domain.JwtPayload) error {
+	return t.tokenCache.ResetRefreshTokenExpiry(ctx, payload)
 }
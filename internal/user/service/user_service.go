@@ -1,9 +1,10 @@
 package service
 
 import (
-	"fmt"
+	"context"
 	"go.uber.org/zap"
-	"os"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/logger"
 	"sass-scaffold/internal/common/reskit/codes"
 	"sass-scaffold/internal/common/utils"
 	"time"
@@ -15,38 +16,32 @@ import (
 
 type userService struct {
 	userRepo	domain.UserRepository
+	teamRepo	domain.TeamRepository
 	tokenService	domain.TokenService
+	bus		*eventbus.Bus
 }
 
-var (
-	githubClientID		string
-	githubClientSecret	string
-)
-
-func NewUserService(userRepo domain.UserRepository, tokenService domain.TokenService) domain.UserService {
-	githubClientID = os.Getenv("GITHUB_CLIENT_ID")
-	githubClientSecret = os.Getenv("GITHUB_CLIENT_SECRET")
-	if githubClientID == "" || githubClientSecret == "" {
-		panic("加载环境变量失败")
-	}
+func NewUserService(userRepo domain.UserRepository, teamRepo domain.TeamRepository, tokenService domain.TokenService, bus *eventbus.Bus) domain.UserService {
 	return &userService{
 		userRepo:	userRepo,
+		teamRepo:	teamRepo,
 		tokenService:	tokenService,
+		bus:		bus,
 	}
 }
 
-func (s *userService) AuthenticateWithOAuth(provider string, userInfo *domain.OAuthUserInfo) (*domain.User2Token, error) {
+func (s *userService) AuthenticateWithOAuth(ctx context.Context, provider string, userInfo *domain.OAuthUserInfo) (*domain.User2Token, error) {
 	// 1. 查找或创建用户
-	user, isNewUser, err := s.findOrCreateUserByOAuth(provider, userInfo)
+	user, isNewUser, err := s.findOrCreateUserByOAuth(ctx, provider, userInfo)
 	if err != nil {
 		return nil, err
 	}
 
 	// 2. 更新最后登录时间（如果不是新用户）
 	if !isNewUser {
-		if err := s.userRepo.UpdateLastLogin(user.ID); err != nil {
+		if err := s.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
 			// 这个错误不应该阻止登录流程，记录日志即可
-			zap.L().Error("更新用户最后登录时间失败", zap.String("user_id", user.ID), zap.Error(err))
+			logger.FromContext(ctx).Error("更新用户最后登录时间失败", zap.String("user_id", user.ID), zap.Error(err))
 		}
 	}
 
@@ -56,31 +51,32 @@ func (s *userService) AuthenticateWithOAuth(provider string, userInfo *domain.OA
 		RandomCode:	utils.GenRandomCodeForJWT(),
 	}
 
-	accessToken, err := s.tokenService.GenerateAccessToken(payload)
+	accessToken, err := s.tokenService.GenerateAccessToken(ctx, payload)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	refreshToken, err := s.tokenService.GenerateRefreshToken(payload)
+	refreshToken, err := s.tokenService.GenerateRefreshToken(ctx, payload)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	return &domain.User2Token{
+		User:		user,
 		AccessToken:	accessToken,
 		RefreshToken:	refreshToken,
 	}, nil
 }
 
-func (s *userService) RefreshUserToken(payload domain.JwtPayload, refreshToken string) (*domain.User2Token, error) {
+func (s *userService) RefreshUserToken(ctx context.Context, payload domain.JwtPayload, refreshToken string) (*domain.User2Token, error) {
 	//1 . 生成新的 access token
-	accessToken, err := s.tokenService.RefreshAccessToken(payload, refreshToken)
+	accessToken, err := s.tokenService.RefreshAccessToken(ctx, payload, refreshToken)
 	if err != nil {
 		return nil, err
 	}
 
 	//2. 刷新refresh token的时间
-	if err := s.tokenService.ResetRefreshTokenExpiry(payload); err != nil {
+	if err := s.tokenService.ResetRefreshTokenExpiry(ctx, payload); err != nil {
 		return nil, err
 	}
 
@@ -90,12 +86,12 @@ func (s *userService) RefreshUserToken(payload domain.JwtPayload, refreshToken s
 	}, nil
 }
 
-func (s *userService) GetUser(userID string) (*domain.User, error) {
-	return s.userRepo.FindByID(userID)
+func (s *userService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	return s.userRepo.FindByID(ctx, userID)
 }
 
-func (s *userService) UpdateUserProfile(userID string, updates *domain.UserProfileUpdate) (*domain.User, error) {
-	user, err := s.userRepo.FindByID(userID)
+func (s *userService) UpdateUserProfile(ctx context.Context, userID string, updates *domain.UserProfileUpdate) (*domain.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +102,7 @@ func (s *userService) UpdateUserProfile(userID string, updates *domain.UserProfi
 	}
 	if updates.Username != nil {
 		// 检查用户名是否已被使用
-		if exists, err := s.userRepo.UsernameExists(*updates.Username); err != nil {
+		if exists, err := s.userRepo.UsernameExists(ctx, *updates.Username); err != nil {
 			return nil, err
 		} else if exists {
 			return nil, codes.ErrUserAlreadyExists
@@ -116,29 +112,17 @@ func (s *userService) UpdateUserProfile(userID string, updates *domain.UserProfi
 	if updates.Avatar != nil {
 		user.AvatarURL = *updates.Avatar
 	}
+	if updates.Locale != nil {
+		user.Locale = *updates.Locale
+	}
 
-	return s.userRepo.Update(user)
-}
-
-func (s *userService) CreateTeam(ownerID string, teamInfo *domain.TeamCreateRequest) (*domain.Team, error) {
-	// TODO: 实现团队创建逻辑
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (s *userService) GetUserTeams(userID string) ([]*domain.Team, error) {
-	// TODO: 实现获取用户团队逻辑
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (s *userService) JoinTeam(userID, teamID string) error {
-	// TODO: 实现加入团队逻辑
-	return fmt.Errorf("not implemented")
+	return s.userRepo.Update(ctx, user)
 }
 
 // 私有辅助方法
-func (s *userService) findOrCreateUserByOAuth(provider string, userInfo *domain.OAuthUserInfo) (*domain.User, bool, error) {
+func (s *userService) findOrCreateUserByOAuth(ctx context.Context, provider string, userInfo *domain.OAuthUserInfo) (*domain.User, bool, error) {
 	// 1. 先通过 OAuth ID 查找
-	user, err := s.userRepo.FindByOAuthID(provider, userInfo.ID)
+	user, err := s.userRepo.FindByOAuthID(ctx, provider, userInfo.ID)
 	if err == nil {
 		// 找到用户，更新信息
 		return user, false, nil
@@ -150,10 +134,10 @@ func (s *userService) findOrCreateUserByOAuth(provider string, userInfo *domain.
 
 	// 2. 通过邮箱查找现有用户
 	if userInfo.Email != "" {
-		user, err = s.userRepo.FindByEmail(userInfo.Email)
+		user, err = s.userRepo.FindByEmail(ctx, userInfo.Email)
 		if err == nil {
 			// 绑定 OAuth 到现有用户
-			user, err = s.bindOAuthToUser(user, provider, userInfo)
+			user, err = s.bindOAuthToUser(ctx, user, provider, userInfo)
 			return user, false, err
 		}
 
@@ -163,13 +147,13 @@ func (s *userService) findOrCreateUserByOAuth(provider string, userInfo *domain.
 	}
 
 	// 3. 创建新用户
-	user, err = s.createUserFromOAuth(provider, userInfo)
+	user, err = s.createUserFromOAuth(ctx, provider, userInfo)
 	return user, true, err
 }
 
-func (s *userService) createUserFromOAuth(provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
+func (s *userService) createUserFromOAuth(ctx context.Context, provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
 	// 生成唯一用户名
-	username, err := s.userRepo.GenerateUniqueUsername(userInfo.Login)
+	username, err := s.userRepo.GenerateUniqueUsername(ctx, userInfo.Login)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +165,7 @@ func (s *userService) createUserFromOAuth(provider string, userInfo *domain.OAut
 		AvatarURL:	userInfo.Avatar,
 		EmailVerified:	true,	// OAuth 用户邮箱已验证
 		Status:		"active",
+		Locale:		userInfo.Locale,
 		CreatedAt:	time.Now(),
 		UpdatedAt:	time.Now(),
 	}
@@ -195,10 +180,23 @@ func (s *userService) createUserFromOAuth(provider string, userInfo *domain.OAut
 		user.GitlabID = userInfo.ID
 	}
 
-	return s.userRepo.Create(user)
+	user, err = s.userRepo.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, eventbus.UserRegistered{
+		UserID:		user.ID,
+		Email:		user.Email,
+		Username:	user.Username,
+		Provider:	provider,
+		OccurredAt:	time.Now(),
+	})
+
+	return user, nil
 }
 
-func (s *userService) bindOAuthToUser(user *domain.User, provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
+func (s *userService) bindOAuthToUser(ctx context.Context, user *domain.User, provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
 	// 设置 OAuth ID
 	switch provider {
 	case "github":
@@ -215,5 +213,5 @@ func (s *userService) bindOAuthToUser(user *domain.User, provider string, userIn
 	}
 
 	user.UpdatedAt = time.Now()
-	return s.userRepo.Update(user)
+	return s.userRepo.Update(ctx, user)
 }
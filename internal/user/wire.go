@@ -6,19 +6,70 @@ package user
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
+	"google.golang.org/grpc"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
 	"sass-scaffold/internal/user/adapters"
+	"sass-scaffold/internal/user/domain"
 	"sass-scaffold/internal/user/handler"
 	"sass-scaffold/internal/user/service"
 )
 
-func InitV1(r *gin.RouterGroup) func() {
+func InitV1(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
 	wire.Build(
 		RegisterV1,
 		handler.NewHttpHandler,
 		service.NewTokenService,
 		service.NewUserService,
 		adapters.NewPSQLUserRepository,
+		adapters.NewPSQLTeamRepository,
 		adapters.NewRedisTokenCache,
+		eventbus.GetBusInstance,
+		config.ProviderSet,
+		datastore.ProviderSet,
+	)
+	return nil
+}
+
+// InitAuth 认证中间件 由各模块的路由共用
+func InitAuth() *auth.Middleware {
+	wire.Build(
+		auth.ProviderSet,
+		wire.Bind(new(auth.Verifier), new(domain.TokenService)),
+		service.NewTokenService,
+		adapters.NewPSQLUserRepository,
+		adapters.NewRedisTokenCache,
+		config.ProviderSet,
+		datastore.ProviderSet,
+	)
+	return nil
+}
+
+func InitGrpcV1(s grpc.ServiceRegistrar) func() {
+	wire.Build(
+		RegisterGrpcV1,
+		handler.NewGrpcHandler,
+		service.NewTokenService,
+		service.NewUserService,
+		adapters.NewPSQLUserRepository,
+		adapters.NewPSQLTeamRepository,
+		adapters.NewRedisTokenCache,
+		eventbus.GetBusInstance,
+		config.ProviderSet,
+		datastore.ProviderSet,
+	)
+	return nil
+}
+
+// InitNotifier 用户通知邮件 由main订阅一次
+func InitNotifier() *service.Notifier {
+	wire.Build(
+		service.NewNotifier,
+		adapters.NewPSQLUserRepository,
+		adapters.NewPSQLTeamRepository,
+		datastore.ProviderSet,
 	)
 	return nil
 }
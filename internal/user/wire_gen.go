@@ -8,6 +8,11 @@ package user
 
 import (
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
 	"sass-scaffold/internal/user/adapters"
 	"sass-scaffold/internal/user/handler"
 	"sass-scaffold/internal/user/service"
@@ -15,12 +20,55 @@ import (
 
 // Injectors from wire.go:
 
-func InitV1(r *gin.RouterGroup) func() {
-	userRepository := adapters.NewPSQLUserRepository()
-	tokenCache := adapters.NewRedisTokenCache()
-	tokenService := service.NewTokenService(tokenCache, userRepository)
-	userService := service.NewUserService(userRepository, tokenService)
-	httpHandler := handler.NewHttpHandler(userService)
-	v := RegisterV1(r, httpHandler)
+func InitV1(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+	db := datastore.GetDBInstance()
+	userRepository := adapters.NewPSQLUserRepository(db)
+	client := datastore.GetRedisInstance()
+	tokenCache := adapters.NewRedisTokenCache(client)
+	source := config.NewSource()
+	tokenService := service.NewTokenService(tokenCache, userRepository, source)
+	teamRepository := adapters.NewPSQLTeamRepository(db)
+	bus := eventbus.GetBusInstance()
+	userService := service.NewUserService(userRepository, teamRepository, tokenService, bus)
+	configConfig := config.GetConfigInstance()
+	githubConfig := configConfig.Github
+	httpHandler := handler.NewHttpHandler(userService, bus, githubConfig)
+	v := RegisterV1(r, httpHandler, authMiddleware)
 	return v
 }
+
+// InitAuth 认证中间件 由各模块的路由共用
+func InitAuth() *auth.Middleware {
+	db := datastore.GetDBInstance()
+	userRepository := adapters.NewPSQLUserRepository(db)
+	client := datastore.GetRedisInstance()
+	tokenCache := adapters.NewRedisTokenCache(client)
+	source := config.NewSource()
+	tokenService := service.NewTokenService(tokenCache, userRepository, source)
+	middleware := auth.NewMiddleware(tokenService)
+	return middleware
+}
+
+func InitGrpcV1(s grpc.ServiceRegistrar) func() {
+	db := datastore.GetDBInstance()
+	userRepository := adapters.NewPSQLUserRepository(db)
+	client := datastore.GetRedisInstance()
+	tokenCache := adapters.NewRedisTokenCache(client)
+	source := config.NewSource()
+	tokenService := service.NewTokenService(tokenCache, userRepository, source)
+	teamRepository := adapters.NewPSQLTeamRepository(db)
+	bus := eventbus.GetBusInstance()
+	userService := service.NewUserService(userRepository, teamRepository, tokenService, bus)
+	grpcHandler := handler.NewGrpcHandler(userService, tokenService, bus)
+	v := RegisterGrpcV1(s, grpcHandler)
+	return v
+}
+
+// InitNotifier 用户通知邮件 由main订阅一次
+func InitNotifier() *service.Notifier {
+	db := datastore.GetDBInstance()
+	userRepository := adapters.NewPSQLUserRepository(db)
+	teamRepository := adapters.NewPSQLTeamRepository(db)
+	notifier := service.NewNotifier(userRepository, teamRepository)
+	return notifier
+}
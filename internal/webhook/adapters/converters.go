@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/webhook/domain"
+)
+
+func domainEndpointToORM(endpoint *domain.Endpoint) *orm.WebhookEndpoint {
+	if endpoint == nil {
+		return nil
+	}
+
+	ormEndpoint := &orm.WebhookEndpoint{
+		WebhookID:  endpoint.ID,
+		OwnerID:    endpoint.OwnerID,
+		TeamID:     endpoint.TeamID,
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		EventTypes: types.StringArray(endpoint.EventTypes),
+		CreatedBy:  endpoint.CreatedBy,
+		Status:     endpoint.Status,
+		CreatedAt:  endpoint.CreatedAt,
+		UpdatedAt:  endpoint.UpdatedAt,
+	}
+
+	if endpoint.Description != "" {
+		ormEndpoint.Description = null.StringFrom(endpoint.Description)
+	}
+
+	return ormEndpoint
+}
+
+func ormEndpointToDomain(ormEndpoint *orm.WebhookEndpoint) *domain.Endpoint {
+	if ormEndpoint == nil {
+		return nil
+	}
+
+	endpoint := &domain.Endpoint{
+		ID:         ormEndpoint.WebhookID,
+		OwnerID:    ormEndpoint.OwnerID,
+		TeamID:     ormEndpoint.TeamID,
+		URL:        ormEndpoint.URL,
+		Secret:     ormEndpoint.Secret,
+		EventTypes: []string(ormEndpoint.EventTypes),
+		CreatedBy:  ormEndpoint.CreatedBy,
+		Status:     ormEndpoint.Status,
+		CreatedAt:  ormEndpoint.CreatedAt,
+		UpdatedAt:  ormEndpoint.UpdatedAt,
+	}
+
+	if ormEndpoint.Description.Valid {
+		endpoint.Description = ormEndpoint.Description.String
+	}
+
+	return endpoint
+}
+
+func domainDeliveryToORM(delivery *domain.Delivery) *orm.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+
+	ormDelivery := &orm.WebhookDelivery{
+		DeliveryID:   delivery.ID,
+		OwnerID:      delivery.OwnerID,
+		WebhookID:    delivery.WebhookID,
+		TeamID:       delivery.TeamID,
+		EventID:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Payload:      types.JSON(delivery.Payload),
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		IsRedelivery: delivery.IsRedelivery,
+		CreatedAt:    delivery.CreatedAt,
+	}
+
+	if delivery.ResponseCode != nil {
+		ormDelivery.ResponseCode = null.IntFrom(*delivery.ResponseCode)
+	}
+
+	if delivery.ResponseBody != "" {
+		ormDelivery.ResponseBody = null.StringFrom(delivery.ResponseBody)
+	}
+
+	if delivery.Error != "" {
+		ormDelivery.Error = null.StringFrom(delivery.Error)
+	}
+
+	if delivery.DurationMs != nil {
+		ormDelivery.DurationMS = null.IntFrom(*delivery.DurationMs)
+	}
+
+	if delivery.DeliveredAt != nil {
+		ormDelivery.DeliveredAt = null.TimeFrom(*delivery.DeliveredAt)
+	}
+
+	return ormDelivery
+}
+
+func ormDeliveryToDomain(ormDelivery *orm.WebhookDelivery) *domain.Delivery {
+	if ormDelivery == nil {
+		return nil
+	}
+
+	delivery := &domain.Delivery{
+		ID:           ormDelivery.DeliveryID,
+		OwnerID:      ormDelivery.OwnerID,
+		WebhookID:    ormDelivery.WebhookID,
+		TeamID:       ormDelivery.TeamID,
+		EventID:      ormDelivery.EventID,
+		EventType:    ormDelivery.EventType,
+		Payload:      []byte(ormDelivery.Payload),
+		Status:       ormDelivery.Status,
+		Attempts:     ormDelivery.Attempts,
+		IsRedelivery: ormDelivery.IsRedelivery,
+		CreatedAt:    ormDelivery.CreatedAt,
+	}
+
+	if ormDelivery.ResponseCode.Valid {
+		delivery.ResponseCode = &ormDelivery.ResponseCode.Int
+	}
+
+	if ormDelivery.ResponseBody.Valid {
+		delivery.ResponseBody = ormDelivery.ResponseBody.String
+	}
+
+	if ormDelivery.Error.Valid {
+		delivery.Error = ormDelivery.Error.String
+	}
+
+	if ormDelivery.DurationMS.Valid {
+		delivery.DurationMs = &ormDelivery.DurationMS.Int
+	}
+
+	if ormDelivery.DeliveredAt.Valid {
+		delivery.DeliveredAt = &ormDelivery.DeliveredAt.Time
+	}
+
+	return delivery
+}
@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"resty.dev/v3"
+
+	"sass-scaffold/internal/common/tracing"
+	"sass-scaffold/internal/webhook/domain"
+)
+
+const (
+	sendTimeout     = 10 * time.Second
+	dialTimeout     = 5 * time.Second
+	maxResponseBody = 1024 // 投递记录中保留的响应体长度
+)
+
+// ErrAddressBlocked 端点解析到内网或本机地址
+var ErrAddressBlocked = errors.New("禁止投递到内网或本机地址")
+
+type HTTPSender struct {
+	client *resty.Client
+}
+
+func NewHTTPSender() domain.Sender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 不走代理 保证拨号检查的是端点本身的地址
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout: dialTimeout,
+		Control: blockPrivateAddress,
+	}).DialContext
+
+	return NewHTTPSenderWithClient(tracing.NewHTTPClientWithTransport(sendTimeout, transport))
+}
+
+// NewHTTPSenderWithClient 使用自定义的 http.Client 便于对接本地 httptest 服务
+func NewHTTPSenderWithClient(client *http.Client) *HTTPSender {
+	return &HTTPSender{
+		client: resty.NewWithClient(client),
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, endpoint *domain.Endpoint, delivery *domain.Delivery) (*domain.SendResult, error) {
+	start := time.Now()
+	res, err := s.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("User-Agent", "saas-scaffold-webhook/1.0").
+		SetHeader(domain.HeaderEvent, delivery.EventType).
+		SetHeader(domain.HeaderDelivery, delivery.ID).
+		SetHeader(domain.HeaderSignature, domain.Sign(endpoint.Secret, start, delivery.Payload)).
+		SetBody([]byte(delivery.Payload)).
+		Post(endpoint.URL)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.SendResult{
+		StatusCode: res.StatusCode(),
+		Body:       truncateBody(res.String(), maxResponseBody),
+		Duration:   time.Since(start),
+	}, nil
+}
+
+// truncateBody 截断到不超过n字节且不拆分多字节字符 TEXT列不接受非法UTF-8和NUL
+func truncateBody(body string, n int) string {
+	if !utf8.ValidString(body) {
+		body = strings.ToValidUTF8(body, "\uFFFD")
+	}
+	body = strings.ReplaceAll(body, "\x00", "")
+	if len(body) <= n {
+		return body
+	}
+	for n > 0 && !utf8.RuneStart(body[n]) {
+		n--
+	}
+	return body[:n]
+}
+
+// blockPrivateAddress 在DNS解析后检查实际连接的地址 防止通过域名指向内网绕过
+func blockPrivateAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr) {
+		return errors.WithMessage(ErrAddressBlocked, addr.String())
+	}
+	return nil
+}
+
+// 运营商级NAT地址 云厂商常用于内部服务
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
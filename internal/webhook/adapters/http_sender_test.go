@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"sass-scaffold/internal/webhook/domain"
+)
+
+func TestTruncateBodyKeepsRuneBoundary(t *testing.T) {
+	body := strings.Repeat("中", 400) // 每个字符3字节
+	got := truncateBody(body, maxResponseBody)
+	if len(got) > maxResponseBody || !utf8.ValidString(got) {
+		t.Fatalf("len = %d valid = %v", len(got), utf8.ValidString(got))
+	}
+	if got := truncateBody("ok\x00\xff", maxResponseBody); got != "ok�" {
+		t.Fatalf("非法字节处理结果 = %q", got)
+	}
+}
+
+func TestSenderBlocksPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	endpoint := &domain.Endpoint{URL: server.URL, Secret: "s"}
+	delivery := &domain.Delivery{Payload: []byte(`{}`)}
+
+	if _, err := NewHTTPSender().Send(context.Background(), endpoint, delivery); !errors.Is(err, ErrAddressBlocked) {
+		t.Fatalf("投递到本机地址 err = %v", err)
+	}
+	if _, err := NewHTTPSenderWithClient(server.Client()).Send(context.Background(), endpoint, delivery); err != nil {
+		t.Fatalf("自定义client err = %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"sass-scaffold/internal/common/orm"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/teamaccess"
+	"sass-scaffold/internal/common/utils"
+	"sass-scaffold/internal/webhook/domain"
+)
+
+type PSQLWebhookRepository struct {
+	db *sql.DB
+}
+
+func NewPSQLWebhookRepository(db *sql.DB) domain.WebhookRepository {
+	return &PSQLWebhookRepository{
+		db: db,
+	}
+}
+
+func (r *PSQLWebhookRepository) FindTeamAccess(ctx context.Context, teamID, userID string) (*domain.TeamAccess, error) {
+	return teamaccess.Find(ctx, r.db, teamID, userID)
+}
+
+func (r *PSQLWebhookRepository) CreateEndpoint(ctx context.Context, endpoint *domain.Endpoint) (*domain.Endpoint, error) {
+	ormEndpoint := domainEndpointToORM(endpoint)
+
+	if err := ormEndpoint.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return ormEndpointToDomain(ormEndpoint), nil
+}
+
+func (r *PSQLWebhookRepository) FindEndpoint(ctx context.Context, ownerID, webhookID string) (*domain.Endpoint, error) {
+	ormEndpoint, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.OwnerID.EQ(ownerID),
+		orm.WebhookEndpointWhere.WebhookID.EQ(webhookID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormEndpointToDomain(ormEndpoint), nil
+}
+
+func (r *PSQLWebhookRepository) FindTeamEndpoints(ctx context.Context, ownerID, teamID string) ([]*domain.Endpoint, error) {
+	ormEndpoints, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.OwnerID.EQ(ownerID),
+		orm.WebhookEndpointWhere.TeamID.EQ(teamID),
+		qm.OrderBy(orm.WebhookEndpointColumns.CreatedAt+" DESC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	endpoints := make([]*domain.Endpoint, 0, len(ormEndpoints))
+	for _, e := range ormEndpoints {
+		endpoints = append(endpoints, ormEndpointToDomain(e))
+	}
+	return endpoints, nil
+}
+
+func (r *PSQLWebhookRepository) FindActiveEndpointsByEvent(ctx context.Context, ownerID, eventType string) ([]*domain.Endpoint, error) {
+	ormEndpoints, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.OwnerID.EQ(ownerID),
+		orm.WebhookEndpointWhere.Status.EQ("active"),
+		qm.Where("? = ANY("+orm.WebhookEndpointColumns.EventTypes+")", eventType),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	endpoints := make([]*domain.Endpoint, 0, len(ormEndpoints))
+	for _, e := range ormEndpoints {
+		endpoints = append(endpoints, ormEndpointToDomain(e))
+	}
+	return endpoints, nil
+}
+
+func (r *PSQLWebhookRepository) DeleteEndpoint(ctx context.Context, ownerID, webhookID string) error {
+	rows, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.OwnerID.EQ(ownerID),
+		orm.WebhookEndpointWhere.WebhookID.EQ(webhookID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *PSQLWebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.Delivery) (*domain.Delivery, error) {
+	ormDelivery := domainDeliveryToORM(delivery)
+
+	if err := ormDelivery.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return ormDeliveryToDomain(ormDelivery), nil
+}
+
+func (r *PSQLWebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	ormDelivery := domainDeliveryToORM(delivery)
+
+	_, err := ormDelivery.Update(ctx, r.db, boil.Whitelist(
+		orm.WebhookDeliveryColumns.Status,
+		orm.WebhookDeliveryColumns.Attempts,
+		orm.WebhookDeliveryColumns.ResponseCode,
+		orm.WebhookDeliveryColumns.ResponseBody,
+		orm.WebhookDeliveryColumns.Error,
+		orm.WebhookDeliveryColumns.DurationMS,
+		orm.WebhookDeliveryColumns.DeliveredAt,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *PSQLWebhookRepository) FindDelivery(ctx context.Context, ownerID, deliveryID string) (*domain.Delivery, error) {
+	ormDelivery, err := orm.WebhookDeliveries(
+		orm.WebhookDeliveryWhere.OwnerID.EQ(ownerID),
+		orm.WebhookDeliveryWhere.DeliveryID.EQ(deliveryID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormDeliveryToDomain(ormDelivery), nil
+}
+
+func (r *PSQLWebhookRepository) FindDeliveries(ctx context.Context, ownerID, webhookID string, page, pageSize int) ([]*domain.Delivery, int64, error) {
+	offset, err := utils.ComputeOffset(page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	where := []qm.QueryMod{
+		orm.WebhookDeliveryWhere.OwnerID.EQ(ownerID),
+		orm.WebhookDeliveryWhere.WebhookID.EQ(webhookID),
+	}
+
+	total, err := orm.WebhookDeliveries(where...).Count(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %w", err)
+	}
+
+	ormDeliveries, err := orm.WebhookDeliveries(append(where,
+		qm.OrderBy(orm.WebhookDeliveryColumns.CreatedAt+" DESC"),
+		qm.Limit(pageSize),
+		qm.Offset(offset),
+	)...).All(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %w", err)
+	}
+
+	deliveries := make([]*domain.Delivery, 0, len(ormDeliveries))
+	for _, d := range ormDeliveries {
+		deliveries = append(deliveries, ormDeliveryToDomain(d))
+	}
+	return deliveries, total, nil
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "sass-scaffold/internal/common/eventbus"
+
+// EventTypePing 测试投递 不可订阅 只能手动触发
+const EventTypePing = "ping"
+
+// SupportedEventTypes 可订阅的事件类型 新增时需同时在服务中订阅对应事件
+var SupportedEventTypes = []string{
+	eventbus.NameTeamCreated,
+	eventbus.NameMemberInvited,
+	eventbus.NameMemberJoined,
+	eventbus.NameMemberRoleChanged,
+	eventbus.NameMemberRemoved,
+	eventbus.NameProfileUpdated,
+}
+
+func IsSupportedEventType(eventType string) bool {
+	for _, t := range SupportedEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"sass-scaffold/internal/common/teamaccess"
+)
+
+// Webhook 端点
+type Endpoint struct {
+	ID          string    `json:"id"`
+	OwnerID     string    `json:"owner_id"`
+	TeamID      string    `json:"team_id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"-"`
+	EventTypes  []string  `json:"event_types"`
+	Description string    `json:"description,omitempty"`
+	CreatedBy   string    `json:"created_by"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// 投递状态
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusFailed    = "failed"
+)
+
+// Webhook 投递记录
+type Delivery struct {
+	ID           string          `json:"id"`
+	OwnerID      string          `json:"owner_id"`
+	WebhookID    string          `json:"webhook_id"`
+	TeamID       string          `json:"team_id"`
+	EventID      string          `json:"event_id"`
+	EventType    string          `json:"event_type"`
+	Payload      json.RawMessage `json:"payload"`
+	Status       string          `json:"status"`
+	Attempts     int             `json:"attempts"`
+	ResponseCode *int            `json:"response_code,omitempty"`
+	ResponseBody string          `json:"response_body,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	DurationMs   *int            `json:"duration_ms,omitempty"`
+	IsRedelivery bool            `json:"is_redelivery"`
+	CreatedAt    time.Time       `json:"created_at"`
+	DeliveredAt  *time.Time      `json:"delivered_at,omitempty"`
+}
+
+// 单次投递结果（值对象）
+type SendResult struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// 端点创建请求（值对象）
+type EndpointCreate struct {
+	URL         string
+	EventTypes  []string
+	Description string
+}
+
+// 团队访问信息（值对象）
+type TeamAccess = teamaccess.Access
+
+// 投递请求体
+type EventPayload struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
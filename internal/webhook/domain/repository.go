@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+type WebhookRepository interface {
+	// 团队权限
+	FindTeamAccess(ctx context.Context, teamID, userID string) (*TeamAccess, error)
+
+	// 端点管理
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) (*Endpoint, error)
+	FindEndpoint(ctx context.Context, ownerID, webhookID string) (*Endpoint, error)
+	FindTeamEndpoints(ctx context.Context, ownerID, teamID string) ([]*Endpoint, error)
+	FindActiveEndpointsByEvent(ctx context.Context, ownerID, eventType string) ([]*Endpoint, error)
+	DeleteEndpoint(ctx context.Context, ownerID, webhookID string) error
+
+	// 投递记录
+	CreateDelivery(ctx context.Context, delivery *Delivery) (*Delivery, error)
+	UpdateDelivery(ctx context.Context, delivery *Delivery) error
+	FindDelivery(ctx context.Context, ownerID, deliveryID string) (*Delivery, error)
+	FindDeliveries(ctx context.Context, ownerID, webhookID string, page, pageSize int) ([]*Delivery, int64, error)
+}
+
+// 投递发送器
+type Sender interface {
+	Send(ctx context.Context, endpoint *Endpoint, delivery *Delivery) (*SendResult, error)
+}
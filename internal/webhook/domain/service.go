@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, userID, teamID string, req *EndpointCreate) (*Endpoint, error)
+	ListEndpoints(ctx context.Context, userID, teamID string) ([]*Endpoint, error)
+	DeleteEndpoint(ctx context.Context, userID, teamID, webhookID string) error
+
+	ListDeliveries(ctx context.Context, userID, teamID, webhookID string, page, pageSize int) ([]*Delivery, int64, error)
+	Redeliver(ctx context.Context, userID, teamID, webhookID, deliveryID string) (*Delivery, error)
+	// Ping 向端点发送一次测试事件
+	Ping(ctx context.Context, userID, teamID, webhookID string) (*Delivery, error)
+}
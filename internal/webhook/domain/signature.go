@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 投递请求头
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Sign 计算签名 格式为 t=<unix秒>,v1=<hex(HMAC-SHA256(secret, "<t>.<body>"))>
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, computeMAC(secret, t, body))
+}
+
+// VerifySignature 校验签名 tolerance 为允许的时间偏差 用于接收方防重放
+func VerifySignature(secret, header string, body []byte, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			t = v
+		case "v1":
+			v1 = v
+		}
+	}
+	if t == "" || v1 == "" {
+		return errors.New("签名格式错误")
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return errors.New("签名时间戳无效")
+	}
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)).Abs() > tolerance {
+		return errors.New("签名已过期")
+	}
+
+	if !hmac.Equal([]byte(v1), []byte(computeMAC(secret, t, body))) {
+		return errors.New("签名不匹配")
+	}
+	return nil
+}
+
+func computeMAC(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"sass-scaffold/internal/webhook/domain"
+)
+
+// HTTP 请求/响应模型
+type WebhookCreateRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=500"`
+	EventTypes  []string `json:"event_types" binding:"required,min=1,dive,required"`
+	Description string   `json:"description,omitempty" binding:"max=500"`
+}
+
+type DeliveryListRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	TeamID      string    `json:"team_id"`
+	URL         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Description string    `json:"description,omitempty"`
+	Status      string    `json:"status"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// 密钥只在创建时返回一次
+type WebhookCreateResponse struct {
+	*WebhookResponse
+	Secret string `json:"secret"`
+}
+
+type DeliveryResponse struct {
+	ID           string          `json:"id"`
+	WebhookID    string          `json:"webhook_id"`
+	EventID      string          `json:"event_id"`
+	EventType    string          `json:"event_type"`
+	Payload      json.RawMessage `json:"payload"`
+	Status       string          `json:"status"`
+	Attempts     int             `json:"attempts"`
+	ResponseCode *int            `json:"response_code,omitempty"`
+	ResponseBody string          `json:"response_body,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	DurationMs   *int            `json:"duration_ms,omitempty"`
+	IsRedelivery bool            `json:"is_redelivery"`
+	CreatedAt    time.Time       `json:"created_at"`
+	DeliveredAt  *time.Time      `json:"delivered_at,omitempty"`
+}
+
+type DeliveryListResponse struct {
+	List  []*DeliveryResponse `json:"list"`
+	Total int64               `json:"total"`
+	Pages int                 `json:"pages"`
+}
+
+// 转换函数
+func DomainEndpointToResponse(endpoint *domain.Endpoint) *WebhookResponse {
+	if endpoint == nil {
+		return nil
+	}
+
+	return &WebhookResponse{
+		ID:          endpoint.ID,
+		TeamID:      endpoint.TeamID,
+		URL:         endpoint.URL,
+		EventTypes:  endpoint.EventTypes,
+		Description: endpoint.Description,
+		Status:      endpoint.Status,
+		CreatedBy:   endpoint.CreatedBy,
+		CreatedAt:   endpoint.CreatedAt,
+		UpdatedAt:   endpoint.UpdatedAt,
+	}
+}
+
+func DomainEndpointsToResponse(endpoints []*domain.Endpoint) []*WebhookResponse {
+	res := make([]*WebhookResponse, 0, len(endpoints))
+	for _, e := range endpoints {
+		res = append(res, DomainEndpointToResponse(e))
+	}
+	return res
+}
+
+func DomainDeliveryToResponse(delivery *domain.Delivery) *DeliveryResponse {
+	if delivery == nil {
+		return nil
+	}
+
+	return &DeliveryResponse{
+		ID:           delivery.ID,
+		WebhookID:    delivery.WebhookID,
+		EventID:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Payload:      delivery.Payload,
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		ResponseBody: delivery.ResponseBody,
+		Error:        delivery.Error,
+		DurationMs:   delivery.DurationMs,
+		IsRedelivery: delivery.IsRedelivery,
+		CreatedAt:    delivery.CreatedAt,
+		DeliveredAt:  delivery.DeliveredAt,
+	}
+}
+
+func HTTPWebhookCreateToDomain(req *WebhookCreateRequest) *domain.EndpointCreate {
+	return &domain.EndpointCreate{
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+	}
+}
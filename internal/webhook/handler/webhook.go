@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/reskit/response"
+	"sass-scaffold/internal/common/utils"
+)
+
+func (h *HttpHandler) CreateWebhook(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(WebhookCreateRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	endpoint, err := h.service.CreateEndpoint(ctx.Request.Context(), userID, ctx.Param("id"), HTTPWebhookCreateToDomain(req))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &WebhookCreateResponse{
+		WebhookResponse: DomainEndpointToResponse(endpoint),
+		Secret:          endpoint.Secret,
+	})
+}
+
+func (h *HttpHandler) ListWebhooks(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	endpoints, err := h.service.ListEndpoints(ctx.Request.Context(), userID, ctx.Param("id"))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainEndpointsToResponse(endpoints))
+}
+
+func (h *HttpHandler) DeleteWebhook(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.service.DeleteEndpoint(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("webhook_id")); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, nil)
+}
+
+func (h *HttpHandler) ListDeliveries(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(DeliveryListRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	deliveries, total, err := h.service.ListDeliveries(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("webhook_id"), req.Page, req.PageSize)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	pages, err := utils.ComputePages(total, req.PageSize, req.Page)
+	if err != nil {
+		response.ValidationError(ctx, err)
+		return
+	}
+
+	list := make([]*DeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		list = append(list, DomainDeliveryToResponse(d))
+	}
+
+	response.Success(ctx, &DeliveryListResponse{
+		List:  list,
+		Total: total,
+		Pages: pages,
+	})
+}
+
+func (h *HttpHandler) RedeliverWebhook(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	delivery, err := h.service.Redeliver(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("webhook_id"), ctx.Param("delivery_id"))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainDeliveryToResponse(delivery))
+}
+
+func (h *HttpHandler) PingWebhook(ctx *gin.Context) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	delivery, err := h.service.Ping(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("webhook_id"))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, DomainDeliveryToResponse(delivery))
+}
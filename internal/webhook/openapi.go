@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"net/http"
+
+	"sass-scaffold/internal/common/openapi"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/webhook/handler"
+)
+
+// 接口文档 修改router_v1.go中的路由时同步修改
+func init() {
+	teamErrors := []codes.ErrCode{codes.ErrTeamNotFound, codes.ErrTeamPermissionDenied, codes.ErrRateLimitExceeded}
+
+	openapi.Register(
+		openapi.Operation{
+			Method:      http.MethodPost,
+			Path:        "/v1/teams/:id/webhooks",
+			Summary:     "创建Webhook",
+			Description: "签名密钥只在创建时返回一次",
+			Tags:        []string{"webhook"},
+			Auth:        true,
+			Request:     handler.WebhookCreateRequest{},
+			Response:    handler.WebhookCreateResponse{},
+			Errors:      append([]codes.ErrCode{codes.ErrWebhookEventTypeInvalid}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:   http.MethodGet,
+			Path:     "/v1/teams/:id/webhooks",
+			Summary:  "Webhook列表",
+			Tags:     []string{"webhook"},
+			Auth:     true,
+			Response: []*handler.WebhookResponse{},
+			Errors:   teamErrors,
+		},
+		openapi.Operation{
+			Method:  http.MethodDelete,
+			Path:    "/v1/teams/:id/webhooks/:webhook_id",
+			Summary: "删除Webhook",
+			Tags:    []string{"webhook"},
+			Auth:    true,
+			Errors:  append([]codes.ErrCode{codes.ErrWebhookNotFound}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:      http.MethodPost,
+			Path:        "/v1/teams/:id/webhooks/:webhook_id/ping",
+			Summary:     "测试投递",
+			Description: "向端点发送一次ping事件 不重试",
+			Tags:        []string{"webhook"},
+			Auth:        true,
+			Response:    handler.DeliveryResponse{},
+			Errors:      append([]codes.ErrCode{codes.ErrWebhookNotFound}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:   http.MethodGet,
+			Path:     "/v1/teams/:id/webhooks/:webhook_id/deliveries",
+			Summary:  "Webhook投递记录",
+			Tags:     []string{"webhook"},
+			Auth:     true,
+			Query:    handler.DeliveryListRequest{},
+			Response: handler.DeliveryListResponse{},
+			Errors:   append([]codes.ErrCode{codes.ErrWebhookNotFound}, teamErrors...),
+		},
+		openapi.Operation{
+			Method:   http.MethodPost,
+			Path:     "/v1/teams/:id/webhooks/:webhook_id/deliveries/:delivery_id/redeliver",
+			Summary:  "重新投递",
+			Tags:     []string{"webhook"},
+			Auth:     true,
+			Response: handler.DeliveryResponse{},
+			Errors:   append([]codes.ErrCode{codes.ErrWebhookNotFound, codes.ErrWebhookDeliveryNotFound}, teamErrors...),
+		},
+	)
+}
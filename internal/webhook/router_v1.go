@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"github.com/gin-gonic/gin"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/ratelimit"
+	"sass-scaffold/internal/webhook/handler"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler, authMiddleware *auth.Middleware) func() {
+	g := r.Group("/v1/teams/:id/webhooks")
+	g.Use(authMiddleware.Validate(), ratelimit.PerUser())
+	{
+		g.POST("", handler.CreateWebhook)
+		g.GET("", handler.ListWebhooks)
+		g.DELETE("/:webhook_id", handler.DeleteWebhook)
+		g.POST("/:webhook_id/ping", handler.PingWebhook)
+		g.GET("/:webhook_id/deliveries", handler.ListDeliveries)
+		g.POST("/:webhook_id/deliveries/:delivery_id/redeliver", handler.RedeliverWebhook)
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/logger"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/common/utils"
+	"sass-scaffold/internal/webhook/domain"
+)
+
+const (
+	maxAttempts    = 5                // 单次投递最大尝试次数
+	retryBaseDelay = time.Second      // 首次重试等待时间 之后按指数增长
+	retryMaxDelay  = 30 * time.Second // 单次重试最长等待时间
+)
+
+type webhookService struct {
+	repo   domain.WebhookRepository
+	sender domain.Sender
+}
+
+func NewWebhookService(repo domain.WebhookRepository, sender domain.Sender, bus *eventbus.Bus) domain.WebhookService {
+	s := &webhookService{
+		repo:   repo,
+		sender: sender,
+	}
+	s.subscribe(bus)
+	return s
+}
+
+// 订阅需要推送给团队的领域事件
+func (s *webhookService) subscribe(bus *eventbus.Bus) {
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.TeamCreated) error {
+		return s.dispatch(ctx, e.OwnerID, "", e)
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberInvited) error {
+		return s.dispatch(ctx, e.OwnerID, e.TeamID, e)
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberJoined) error {
+		return s.dispatch(ctx, e.OwnerID, e.TeamID, e)
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberRoleChanged) error {
+		return s.dispatch(ctx, e.OwnerID, e.TeamID, e)
+	})
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.MemberRemoved) error {
+		return s.dispatch(ctx, e.OwnerID, e.TeamID, e)
+	})
+	// 只有团队所有者的资料变更会投递到其名下的团队
+	eventbus.OnAsync(bus, func(ctx context.Context, e eventbus.ProfileUpdated) error {
+		return s.dispatch(ctx, e.UserID, "", e)
+	})
+}
+
+func (s *webhookService) CreateEndpoint(ctx context.Context, userID, teamID string, req *domain.EndpointCreate) (*domain.Endpoint, error) {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range req.EventTypes {
+		if !domain.IsSupportedEventType(t) {
+			return nil, codes.ErrWebhookEventTypeInvalid.WithDetail(map[string]any{
+				"event_type": t,
+				"supported":  domain.SupportedEventTypes,
+			})
+		}
+	}
+
+	secret, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	endpoint := &domain.Endpoint{
+		OwnerID:     access.OwnerID,
+		TeamID:      teamID,
+		URL:         req.URL,
+		Secret:      secret,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		CreatedBy:   userID,
+		Status:      "active",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	return s.repo.CreateEndpoint(ctx, endpoint)
+}
+
+func (s *webhookService) ListEndpoints(ctx context.Context, userID, teamID string) ([]*domain.Endpoint, error) {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindTeamEndpoints(ctx, access.OwnerID, teamID)
+}
+
+func (s *webhookService) DeleteEndpoint(ctx context.Context, userID, teamID, webhookID string) error {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.findTeamEndpoint(ctx, access.OwnerID, teamID, webhookID); err != nil {
+		return err
+	}
+	return s.repo.DeleteEndpoint(ctx, access.OwnerID, webhookID)
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, userID, teamID, webhookID string, page, pageSize int) ([]*domain.Delivery, int64, error) {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := s.findTeamEndpoint(ctx, access.OwnerID, teamID, webhookID); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.FindDeliveries(ctx, access.OwnerID, webhookID, page, pageSize)
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, userID, teamID, webhookID, deliveryID string) (*domain.Delivery, error) {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.findTeamEndpoint(ctx, access.OwnerID, teamID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsUUID(deliveryID) {
+		return nil, codes.ErrWebhookDeliveryNotFound
+	}
+	original, err := s.repo.FindDelivery(ctx, access.OwnerID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.WebhookID != webhookID {
+		return nil, codes.ErrWebhookDeliveryNotFound
+	}
+
+	// 重新投递生成新的记录 沿用原事件ID和请求体
+	delivery, err := s.repo.CreateDelivery(ctx, &domain.Delivery{
+		OwnerID:      original.OwnerID,
+		WebhookID:    original.WebhookID,
+		TeamID:       original.TeamID,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		Status:       domain.DeliveryStatusPending,
+		IsRedelivery: true,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 手动重新投递只尝试一次 结果直接返回给调用方 客户端断开时仍需写回投递结果
+	s.deliver(context.WithoutCancel(ctx), endpoint, delivery, 1)
+	return delivery, nil
+}
+
+func (s *webhookService) Ping(ctx context.Context, userID, teamID, webhookID string) (*domain.Delivery, error) {
+	access, err := s.checkAdmin(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.findTeamEndpoint(ctx, access.OwnerID, teamID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventID, err := uuid.NewV4()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	payload, err := json.Marshal(domain.EventPayload{
+		ID:        eventID.String(),
+		Type:      domain.EventTypePing,
+		CreatedAt: time.Now(),
+		Data:      map[string]string{"webhook_id": endpoint.ID},
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	delivery, err := s.repo.CreateDelivery(ctx, &domain.Delivery{
+		OwnerID:   endpoint.OwnerID,
+		WebhookID: endpoint.ID,
+		TeamID:    endpoint.TeamID,
+		EventID:   eventID.String(),
+		EventType: domain.EventTypePing,
+		Payload:   payload,
+		Status:    domain.DeliveryStatusPending,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 与重新投递相同 只尝试一次并直接返回结果
+	s.deliver(context.WithoutCancel(ctx), endpoint, delivery, 1)
+	return delivery, nil
+}
+
+// 私有辅助方法
+func (s *webhookService) checkAdmin(ctx context.Context, userID, teamID string) (*domain.TeamAccess, error) {
+	access, err := s.repo.FindTeamAccess(ctx, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.IsAdmin() {
+		return nil, codes.ErrTeamPermissionDenied
+	}
+	return access, nil
+}
+
+func (s *webhookService) findTeamEndpoint(ctx context.Context, ownerID, teamID, webhookID string) (*domain.Endpoint, error) {
+	if !utils.IsUUID(webhookID) {
+		return nil, codes.ErrWebhookNotFound
+	}
+	endpoint, err := s.repo.FindEndpoint(ctx, ownerID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.TeamID != teamID {
+		return nil, codes.ErrWebhookNotFound
+	}
+	return endpoint, nil
+}
+
+// dispatch 将事件投递给订阅了该事件的端点 teamID 为空时投递给所有者名下的全部团队
+func (s *webhookService) dispatch(ctx context.Context, ownerID, teamID string, e eventbus.Event) error {
+	endpoints, err := s.repo.FindActiveEndpointsByEvent(ctx, ownerID, e.EventName())
+	if err != nil {
+		return err
+	}
+
+	eventID, err := uuid.NewV4()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	payload, err := json.Marshal(domain.EventPayload{
+		ID:        eventID.String(),
+		Type:      e.EventName(),
+		CreatedAt: time.Now(),
+		Data:      e,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		if teamID != "" && endpoint.TeamID != teamID {
+			continue
+		}
+
+		delivery, err := s.repo.CreateDelivery(ctx, &domain.Delivery{
+			OwnerID:   endpoint.OwnerID,
+			WebhookID: endpoint.ID,
+			TeamID:    endpoint.TeamID,
+			EventID:   eventID.String(),
+			EventType: e.EventName(),
+			Payload:   payload,
+			Status:    domain.DeliveryStatusPending,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			logger.FromContext(ctx).Named("webhook").Error("创建Webhook投递记录失败", zap.String("webhook_id", endpoint.ID), zap.Error(err))
+			continue
+		}
+
+		wg.Add(1)
+		go func(endpoint *domain.Endpoint, delivery *domain.Delivery) {
+			defer wg.Done()
+			s.deliver(ctx, endpoint, delivery, maxAttempts)
+		}(endpoint, delivery)
+	}
+	wg.Wait()
+	return nil
+}
+
+// deliver 按指数退避重试投递 每次尝试的结果都会写回投递记录
+func (s *webhookService) deliver(ctx context.Context, endpoint *domain.Endpoint, delivery *domain.Delivery, attempts int) {
+	delay := retryBaseDelay
+	for i := 1; i <= attempts; i++ {
+		result, err := s.sender.Send(ctx, endpoint, delivery)
+		s.applyResult(delivery, result, err)
+
+		if delivery.Status != domain.DeliveryStatusSucceeded && i == attempts {
+			delivery.Status = domain.DeliveryStatusFailed
+		}
+
+		if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+			logger.FromContext(ctx).Named("webhook").Error("更新Webhook投递记录失败", zap.String("delivery_id", delivery.ID), zap.Error(err))
+		}
+
+		if delivery.Status != domain.DeliveryStatusPending {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, retryMaxDelay)
+	}
+}
+
+func (s *webhookService) applyResult(delivery *domain.Delivery, result *domain.SendResult, err error) {
+	delivery.Attempts++
+	if err != nil {
+		delivery.Error = err.Error()
+		delivery.ResponseCode = nil
+		delivery.ResponseBody = ""
+		return
+	}
+
+	statusCode := result.StatusCode
+	durationMs := int(result.Duration.Milliseconds())
+	delivery.ResponseCode = &statusCode
+	delivery.ResponseBody = result.Body
+	delivery.DurationMs = &durationMs
+	delivery.Error = ""
+
+	if statusCode >= 200 && statusCode < 300 {
+		now := time.Now()
+		delivery.Status = domain.DeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+	}
+}
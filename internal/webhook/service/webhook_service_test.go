@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/reskit/codes"
+	"sass-scaffold/internal/webhook/adapters"
+	"sass-scaffold/internal/webhook/domain"
+)
+
+const testSecret = "test-secret"
+
+// 内存仓储 只实现投递流程用到的部分
+type memoryRepo struct {
+	mu         sync.Mutex
+	access     *domain.TeamAccess
+	endpoints  map[string]*domain.Endpoint
+	deliveries map[string]*domain.Delivery
+}
+
+func newMemoryRepo(endpoints ...*domain.Endpoint) *memoryRepo {
+	r := &memoryRepo{
+		access:     &domain.TeamAccess{OwnerID: "owner", Role: "owner"},
+		endpoints:  make(map[string]*domain.Endpoint),
+		deliveries: make(map[string]*domain.Delivery),
+	}
+	for _, e := range endpoints {
+		r.endpoints[e.ID] = e
+	}
+	return r
+}
+
+func (r *memoryRepo) FindTeamAccess(context.Context, string, string) (*domain.TeamAccess, error) {
+	return r.access, nil
+}
+
+func (r *memoryRepo) CreateEndpoint(_ context.Context, e *domain.Endpoint) (*domain.Endpoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e.ID = uuid.Must(uuid.NewV4()).String()
+	r.endpoints[e.ID] = e
+	return e, nil
+}
+
+func (r *memoryRepo) FindEndpoint(_ context.Context, _, webhookID string) (*domain.Endpoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.endpoints[webhookID]
+	if !ok {
+		return nil, codes.ErrWebhookNotFound
+	}
+	return e, nil
+}
+
+func (r *memoryRepo) FindTeamEndpoints(context.Context, string, string) ([]*domain.Endpoint, error) {
+	return nil, nil
+}
+
+func (r *memoryRepo) FindActiveEndpointsByEvent(_ context.Context, _, eventType string) ([]*domain.Endpoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*domain.Endpoint
+	for _, e := range r.endpoints {
+		for _, t := range e.EventTypes {
+			if t == eventType {
+				res = append(res, e)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *memoryRepo) DeleteEndpoint(context.Context, string, string) error { return nil }
+
+func (r *memoryRepo) CreateDelivery(_ context.Context, d *domain.Delivery) (*domain.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d.ID = uuid.Must(uuid.NewV4()).String()
+	r.deliveries[d.ID] = d
+	return d, nil
+}
+
+func (r *memoryRepo) UpdateDelivery(context.Context, *domain.Delivery) error { return nil }
+
+func (r *memoryRepo) FindDelivery(_ context.Context, _, deliveryID string) (*domain.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.deliveries[deliveryID]
+	if !ok {
+		return nil, codes.ErrWebhookDeliveryNotFound
+	}
+	return d, nil
+}
+
+func (r *memoryRepo) FindDeliveries(context.Context, string, string, int, int) ([]*domain.Delivery, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *memoryRepo) only(t *testing.T) *domain.Delivery {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.deliveries) != 1 {
+		t.Fatalf("投递记录数 = %d, 期望 1", len(r.deliveries))
+	}
+	for _, d := range r.deliveries {
+		return d
+	}
+	return nil
+}
+
+func newEndpoint(url string, eventTypes ...string) *domain.Endpoint {
+	return &domain.Endpoint{
+		ID:         uuid.Must(uuid.NewV4()).String(),
+		OwnerID:    "owner",
+		TeamID:     uuid.Must(uuid.NewV4()).String(),
+		URL:        url,
+		Secret:     testSecret,
+		EventTypes: eventTypes,
+		Status:     "active",
+	}
+}
+
+func newTestService(repo *memoryRepo, server *httptest.Server) *webhookService {
+	return &webhookService{
+		repo:   repo,
+		sender: adapters.NewHTTPSenderWithClient(server.Client()),
+	}
+}
+
+func TestDispatchSignsPayload(t *testing.T) {
+	var verifyErr atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := domain.VerifySignature(testSecret, r.Header.Get(domain.HeaderSignature), body, time.Minute); err != nil {
+			verifyErr.Store(err)
+		}
+		if r.Header.Get(domain.HeaderEvent) != eventbus.NameTeamCreated {
+			verifyErr.Store(errors.Errorf("事件请求头 = %q", r.Header.Get(domain.HeaderEvent)))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := newMemoryRepo(newEndpoint(server.URL, eventbus.NameTeamCreated))
+	s := newTestService(repo, server)
+
+	if err := s.dispatch(context.Background(), "owner", "", eventbus.TeamCreated{TeamID: "t", OwnerID: "owner"}); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if err, ok := verifyErr.Load().(error); ok {
+		t.Fatalf("签名校验失败: %v", err)
+	}
+
+	d := repo.only(t)
+	if d.Status != domain.DeliveryStatusSucceeded || d.Attempts != 1 {
+		t.Fatalf("status = %s attempts = %d, 期望一次成功", d.Status, d.Attempts)
+	}
+}
+
+func TestDeliverRetriesUntilSuccess(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := newMemoryRepo(newEndpoint(server.URL, eventbus.NameTeamCreated))
+	s := newTestService(repo, server)
+
+	if err := s.dispatch(context.Background(), "owner", "", eventbus.TeamCreated{}); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+
+	d := repo.only(t)
+	if d.Status != domain.DeliveryStatusSucceeded || d.Attempts != 2 {
+		t.Fatalf("status = %s attempts = %d, 期望第二次成功", d.Status, d.Attempts)
+	}
+}
+
+func TestDeliverMarksFailedAfterLastAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	endpoint := newEndpoint(server.URL)
+	repo := newMemoryRepo(endpoint)
+	s := newTestService(repo, server)
+
+	delivery, _ := repo.CreateDelivery(context.Background(), &domain.Delivery{Payload: []byte(`{}`), Status: domain.DeliveryStatusPending})
+	s.deliver(context.Background(), endpoint, delivery, 2)
+
+	if delivery.Status != domain.DeliveryStatusFailed || delivery.Attempts != 2 {
+		t.Fatalf("status = %s attempts = %d, 期望两次后失败", delivery.Status, delivery.Attempts)
+	}
+	if delivery.ResponseCode == nil || *delivery.ResponseCode != http.StatusBadGateway {
+		t.Fatalf("response_code = %v", delivery.ResponseCode)
+	}
+}
+
+func TestPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	endpoint := newEndpoint(server.URL, eventbus.NameTeamCreated)
+	repo := newMemoryRepo(endpoint)
+	s := newTestService(repo, server)
+
+	delivery, err := s.Ping(context.Background(), "owner", endpoint.TeamID, endpoint.ID)
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if delivery.EventType != domain.EventTypePing || delivery.Status != domain.DeliveryStatusSucceeded {
+		t.Fatalf("event_type = %s status = %s", delivery.EventType, delivery.Status)
+	}
+}
+
+func TestInvalidIDsAreNotFound(t *testing.T) {
+	s := &webhookService{repo: newMemoryRepo()}
+	teamID := uuid.Must(uuid.NewV4()).String()
+
+	if _, err := s.Ping(context.Background(), "owner", teamID, "not-a-uuid"); !errors.Is(err, codes.ErrWebhookNotFound) {
+		t.Fatalf("非法Webhook ID err = %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+//go:build wireinject
+// +build wireinject
+
+package webhook
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/eventbus"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/webhook/adapters"
+	"sass-scaffold/internal/webhook/handler"
+	"sass-scaffold/internal/webhook/service"
+)
+
+func InitV1(r *gin.RouterGroup, authMiddleware *auth.Middleware) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewWebhookService,
+		adapters.NewPSQLWebhookRepository,
+		adapters.NewHTTPSender,
+		eventbus.GetBusInstance,
+		datastore.ProviderSet,
+	)
+	return nil
+}
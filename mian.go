@@ -1,27 +1,129 @@
 package main
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
-	"os"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"reflect"
+	"sass-scaffold/internal/app"
+	"sass-scaffold/internal/common/authguard"
+	"sass-scaffold/internal/common/config"
+	"sass-scaffold/internal/common/datastore"
+	"sass-scaffold/internal/common/email"
+	"sass-scaffold/internal/common/eventbus"
 	"sass-scaffold/internal/common/logger"
 	"sass-scaffold/internal/common/metrics"
+	"sass-scaffold/internal/common/ratelimit"
+	"sass-scaffold/internal/common/reskit/codes"
 	"sass-scaffold/internal/common/server"
+	"sass-scaffold/internal/common/tracing"
 	"sass-scaffold/internal/user"
+	"time"
 )
 
 func main() {
 	var err error
 
-	if err = logger.Init(); err != nil {
+	// 错误码重复或缺少翻译时拒绝启动
+	if err = codes.Validate(); err != nil {
+		panic(errors.WithMessage(err, "codes模块校验失败"))
+	}
+
+	if err = config.Init(); err != nil {
+		panic(errors.WithMessage(err, "config模块初始化失败"))
+	}
+	cfg := config.GetConfigInstance()
+
+	if err = logger.Init(cfg.Log); err != nil {
 		panic(errors.WithMessage(err, "logger模块初始化失败"))
 	}
+	zap.L().Info("配置加载完成", zap.Any("config", cfg))
 
-	//ctx := context.Background()
-	//metricsClient := metrics.NewPrometheusClient()
-	//metrics.StartPrometheusServer()
+	if err = tracing.Init(cfg.Tracing); err != nil {
+		panic(errors.WithMessage(err, "tracing模块初始化失败"))
+	}
+
+	if err = email.Init(cfg.Email); err != nil {
+		panic(errors.WithMessage(err, "email模块初始化失败"))
+	}
+
+	if err = metrics.Init(cfg.Prometheus); err != nil {
+		panic(errors.WithMessage(err, "metrics模块初始化失败"))
+	}
+	metricsClient := metrics.GetClientInstance()
+	metrics.SubscribeBusiness(eventbus.GetBusInstance(), metricsClient)
+
+	if err = datastore.Init(cfg.PSQL, cfg.Redis); err != nil {
+		panic(errors.WithMessage(err, "datastore模块初始化失败"))
+	}
+
+	if err = ratelimit.Init(cfg.RateLimit); err != nil {
+		panic(errors.WithMessage(err, "ratelimit模块初始化失败"))
+	}
 
-	server.RunHttpServer(os.Getenv("SERVER_PORT"), metrics.NoOp{}, func(r *gin.RouterGroup) {
-		user.InitV1(r)
+	if err = authguard.Init(cfg.AuthGuard); err != nil {
+		panic(errors.WithMessage(err, "authguard模块初始化失败"))
+	}
+	notifier := user.InitNotifier()
+	notifier.Subscribe(eventbus.GetBusInstance())
+	authguard.SubscribeLogins(eventbus.GetBusInstance(), notifier.LoginRecipient)
+
+	// SIGHUP热加载 统一在此注册一次 JWT、内省客户端与邮件回调密钥通过config.Source按需读取
+	config.OnReload(func(old, new *config.Config) {
+		if !reflect.DeepEqual(old.Server.AllowOrigins, new.Server.AllowOrigins) {
+			server.SetAllowOrigins(new.Server.AllowOrigins)
+		}
+		if old.Log.Level != new.Log.Level {
+			if err := logger.SetLevel(new.Log.Level); err != nil {
+				zap.L().Error("日志级别更新失败", zap.Error(err))
+			}
+		}
+		if old.Email != new.Email {
+			if err := email.Init(new.Email); err != nil {
+				zap.L().Error("邮件配置更新失败", zap.Error(err))
+			}
+		}
+		if !reflect.DeepEqual(old.RateLimit, new.RateLimit) {
+			if err := ratelimit.SetConfig(new.RateLimit); err != nil {
+				zap.L().Error("限流配置更新失败", zap.Error(err))
+			}
+		}
+		if !reflect.DeepEqual(old.AuthGuard, new.AuthGuard) {
+			authguard.SetConfig(new.AuthGuard)
+		}
 	})
+
+	// 各模块共用的认证中间件
+	authMiddleware := user.InitAuth()
+
+	server.RunHttpServer(cfg.Server, metricsClient, func(r *gin.RouterGroup) {
+		app.RegisterRoutes(r, authMiddleware, app.InitModules())
+	}, func(s *grpc.Server) {
+		user.InitGrpcV1(s)
+	},
+		grpc.ChainUnaryInterceptor(authMiddleware.UnaryServerInterceptor(user.GrpcPublicMethods...)),
+		grpc.ChainStreamInterceptor(authMiddleware.StreamServerInterceptor(user.GrpcPublicMethods...)),
+	)
+
+	// 等待异步事件订阅者处理完成
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err = eventbus.GetBusInstance().Close(ctx); err != nil {
+		zap.L().Error("事件总线关闭失败", zap.Error(err))
+	}
+
+	if err = email.Close(); err != nil {
+		zap.L().Error("邮件客户端关闭失败", zap.Error(err))
+	}
+
+	// 事件订阅者可能仍在写库 最后关闭连接池
+	if err = datastore.Close(); err != nil {
+		zap.L().Error("连接池关闭失败", zap.Error(err))
+	}
+
+	if err = tracing.Close(ctx); err != nil {
+		zap.L().Error("链路追踪关闭失败", zap.Error(err))
+	}
 }
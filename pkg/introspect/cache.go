@@ -0,0 +1,73 @@
+package introspect
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	result    *Result
+	expiresAt time.Time
+}
+
+// 带过期时间的内存缓存 满时先清理过期条目 仍然满时随机淘汰
+type cache struct {
+	mu         sync.Mutex
+	entries    map[string]cacheEntry
+	maxEntries int
+}
+
+func newCache(maxEntries int) *cache {
+	return &cache{
+		entries:    make(map[string]cacheEntry),
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *cache) get(key string, now time.Time) (*Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.result, true
+}
+
+func (c *cache) set(key string, result *Result, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(time.Now())
+	}
+	c.entries[key] = cacheEntry{result: result, expiresAt: expiresAt}
+}
+
+func (c *cache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// 调用方需持有锁
+func (c *cache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	// map遍历顺序随机 相当于随机淘汰
+	for key := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
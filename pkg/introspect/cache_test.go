@@ -0,0 +1,88 @@
+package introspect
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCacheGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		at        time.Time
+		wantHit   bool
+	}{
+		{name: "未过期命中", expiresAt: now.Add(time.Minute), at: now, wantHit: true},
+		{name: "到达过期时间", expiresAt: now.Add(time.Minute), at: now.Add(time.Minute), wantHit: false},
+		{name: "已过期", expiresAt: now.Add(time.Minute), at: now.Add(2 * time.Minute), wantHit: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCache(10)
+			want := &Result{Active: true, Subject: "u1"}
+			c.set("k", want, tt.expiresAt)
+
+			got, ok := c.get("k", tt.at)
+			if ok != tt.wantHit {
+				t.Fatalf("命中=%v 期望%v", ok, tt.wantHit)
+			}
+			if ok && got != want {
+				t.Fatalf("命中的结果与缓存的不同")
+			}
+			if !ok && len(c.entries) != 0 {
+				t.Fatal("过期条目应在读取时删除")
+			}
+		})
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	const max = 3
+	now := time.Now()
+
+	t.Run("满时优先清理过期条目", func(t *testing.T) {
+		c := newCache(max)
+		c.set("expired", &Result{}, now.Add(-time.Second))
+		c.set("a", &Result{}, now.Add(time.Minute))
+		c.set("b", &Result{}, now.Add(time.Minute))
+
+		c.set("c", &Result{}, now.Add(time.Minute))
+		if _, ok := c.entries["expired"]; ok {
+			t.Fatal("过期条目应被清理")
+		}
+		for _, key := range []string{"a", "b", "c"} {
+			if _, ok := c.get(key, now); !ok {
+				t.Fatalf("未过期的%s不应被淘汰", key)
+			}
+		}
+	})
+
+	t.Run("仍然满时淘汰其他条目", func(t *testing.T) {
+		c := newCache(max)
+		for i := range 10 {
+			c.set(strconv.Itoa(i), &Result{}, now.Add(time.Minute))
+			if len(c.entries) > max {
+				t.Fatalf("条目数=%d 超过上限%d", len(c.entries), max)
+			}
+		}
+		if _, ok := c.get("9", now); !ok {
+			t.Fatal("最新写入的条目应保留")
+		}
+	})
+
+	t.Run("更新已有条目不淘汰", func(t *testing.T) {
+		c := newCache(max)
+		for _, key := range []string{"a", "b", "c"} {
+			c.set(key, &Result{}, now.Add(time.Minute))
+		}
+		c.set("a", &Result{Subject: "new"}, now.Add(time.Minute))
+		if len(c.entries) != max {
+			t.Fatalf("条目数=%d 期望%d", len(c.entries), max)
+		}
+		if got, _ := c.get("a", now); got.Subject != "new" {
+			t.Fatal("条目应被更新")
+		}
+	})
+}
@@ -0,0 +1,244 @@
+// Package introspect 令牌内省客户端 供其他服务校验本服务签发的access token
+// 只依赖标准库 调用/api/v1/oauth/introspect并在本地缓存结果
+package introspect
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultCacheTTL   = 30 * time.Second
+	defaultMaxEntries = 10000
+	defaultTimeout    = 5 * time.Second
+)
+
+// ErrInactive 令牌无效、已过期或用户已被禁用
+var ErrInactive = errors.New("introspect: token is not active")
+
+// Config 客户端配置 Endpoint、ClientID、ClientSecret必填
+type Config struct {
+	// 内省接口地址 如https://api.example.com/api/v1/oauth/introspect
+	Endpoint     string
+	ClientID     string
+	ClientSecret string
+	// 有效令牌的缓存时长 不超过令牌剩余有效期 默认30s 负数关闭缓存
+	CacheTTL time.Duration
+	// 无效令牌的缓存时长 默认不缓存 频繁收到无效令牌时可设为几秒以减少内省请求
+	NegativeCacheTTL time.Duration
+	// 缓存的最大令牌数 默认10000
+	MaxEntries int
+	// 为空时使用超时5s的默认客户端
+	HTTPClient *http.Client
+}
+
+// Team 用户所属的团队 所有者的角色为owner
+type Team struct {
+	TeamID  string `json:"team_id"`
+	OwnerID string `json:"owner_id"`
+	Role    string `json:"role"`
+}
+
+// Result RFC 7662格式的内省结果 Active为false时其余字段为空
+type Result struct {
+	Active    bool   `json:"active"`
+	Subject   string `json:"sub,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	// 空格分隔的权限范围
+	Scope string `json:"scope,omitempty"`
+	// 令牌不受scope限制 用户登录签发的令牌为true
+	Unrestricted bool   `json:"unrestricted,omitempty"`
+	Teams        []Team `json:"teams,omitempty"`
+}
+
+// UserID 令牌所属的用户ID
+func (r *Result) UserID() string {
+	return r.Subject
+}
+
+// Expiry 令牌过期时间 未知时返回零值
+func (r *Result) Expiry() time.Time {
+	if r.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(r.ExpiresAt, 0)
+}
+
+// HasScope 令牌是否允许访问该scope 与服务端认证中间件的规则一致
+func (r *Result) HasScope(scope string) bool {
+	return r.Unrestricted || slices.Contains(strings.Fields(r.Scope), scope)
+}
+
+// Role 用户在团队中的角色 不属于该团队时返回false
+func (r *Result) Role(teamID string) (string, bool) {
+	for _, team := range r.Teams {
+		if team.TeamID == teamID {
+			return team.Role, true
+		}
+	}
+	return "", false
+}
+
+// StatusError 内省接口返回非200状态
+type StatusError struct {
+	StatusCode int
+	// 统一错误响应中的业务错误码与信息 响应体无法解析时为空
+	Code    int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	if e.Code != 0 {
+		return fmt.Sprintf("introspect: status %d, code %d: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("introspect: status %d", e.StatusCode)
+}
+
+// Client 并发安全
+type Client struct {
+	cfg   Config
+	http  *http.Client
+	cache *cache
+}
+
+func New(cfg Config) (*Client, error) {
+	if cfg.Endpoint == "" || cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, errors.New("introspect: Endpoint, ClientID and ClientSecret are required")
+	}
+	if _, err := url.ParseRequestURI(cfg.Endpoint); err != nil {
+		return nil, fmt.Errorf("introspect: invalid Endpoint: %w", err)
+	}
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = defaultMaxEntries
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return &Client{
+		cfg:   cfg,
+		http:  httpClient,
+		cache: newCache(cfg.MaxEntries),
+	}, nil
+}
+
+// Introspect 查询令牌状态 令牌无效时返回Active为false的结果而不是错误
+// 返回的Result在缓存中共享 调用方不应修改
+func (c *Client) Introspect(ctx context.Context, token string) (*Result, error) {
+	if token == "" {
+		return &Result{Active: false}, nil
+	}
+
+	key := cacheKey(token)
+	if res, ok := c.cache.get(key, time.Now()); ok {
+		return res, nil
+	}
+
+	res, err := c.fetch(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl := c.ttl(res, time.Now()); ttl > 0 {
+		c.cache.set(key, res, time.Now().Add(ttl))
+	}
+	return res, nil
+}
+
+// Validate 令牌无效时返回ErrInactive
+func (c *Client) Validate(ctx context.Context, token string) (*Result, error) {
+	res, err := c.Introspect(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Active {
+		return nil, ErrInactive
+	}
+	return res, nil
+}
+
+// Forget 删除令牌的缓存 用户登出时调用
+func (c *Client) Forget(token string) {
+	c.cache.delete(cacheKey(token))
+}
+
+func (c *Client) fetch(ctx context.Context, token string) (*Result, error) {
+	form := url.Values{
+		"token":           {token},
+		"token_type_hint": {"access_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.cfg.ClientID, c.cfg.ClientSecret)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("introspect: read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		statusErr := &StatusError{StatusCode: resp.StatusCode}
+		var envelope struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &envelope) == nil {
+			statusErr.Code = envelope.Code
+			statusErr.Message = envelope.Message
+		}
+		return nil, statusErr
+	}
+
+	res := new(Result)
+	if err := json.Unmarshal(body, res); err != nil {
+		return nil, fmt.Errorf("introspect: decode response: %w", err)
+	}
+	return res, nil
+}
+
+// 有效令牌缓存到过期前 避免缓存已过期的令牌 无效令牌默认不缓存
+func (c *Client) ttl(res *Result, now time.Time) time.Duration {
+	if !res.Active {
+		return c.cfg.NegativeCacheTTL
+	}
+
+	ttl := c.cfg.CacheTTL
+	if exp := res.Expiry(); !exp.IsZero() && exp.Sub(now) < ttl {
+		ttl = exp.Sub(now)
+	}
+	return ttl
+}
+
+// 缓存中不保存令牌原文
+func cacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,126 @@
+package introspect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestServer 返回固定结果的内省接口 并统计调用次数
+func newTestServer(t *testing.T, result *Result) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newTestClient(t *testing.T, endpoint string, cfg Config) *Client {
+	t.Helper()
+	cfg.Endpoint = endpoint
+	cfg.ClientID = "client"
+	cfg.ClientSecret = "secret"
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestIntrospectCache(t *testing.T) {
+	active := &Result{Active: true, Subject: "u1", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+	tests := []struct {
+		name      string
+		result    *Result
+		cfg       Config
+		wantCalls int32
+	}{
+		{name: "有效令牌命中缓存", result: active, wantCalls: 1},
+		{name: "关闭缓存", result: active, cfg: Config{CacheTTL: -1}, wantCalls: 3},
+		{name: "无效令牌默认不缓存", result: &Result{Active: false}, wantCalls: 3},
+		{name: "显式开启无效令牌缓存", result: &Result{Active: false}, cfg: Config{NegativeCacheTTL: time.Minute}, wantCalls: 1},
+		{
+			name:      "即将过期的令牌不缓存",
+			result:    &Result{Active: true, Subject: "u1", ExpiresAt: time.Now().Add(-time.Second).Unix()},
+			wantCalls: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, calls := newTestServer(t, tt.result)
+			c := newTestClient(t, srv.URL, tt.cfg)
+
+			for range 3 {
+				res, err := c.Introspect(context.Background(), "token")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Active != tt.result.Active {
+					t.Fatalf("Active=%v 期望%v", res.Active, tt.result.Active)
+				}
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Fatalf("内省接口调用%d次 期望%d次", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIntrospectCacheExpiry(t *testing.T) {
+	srv, calls := newTestServer(t, &Result{Active: true, Subject: "u1"})
+	c := newTestClient(t, srv.URL, Config{CacheTTL: 20 * time.Millisecond})
+
+	for range 2 {
+		if _, err := c.Introspect(context.Background(), "token"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, err := c.Introspect(context.Background(), "token"); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("内省接口调用%d次 期望缓存过期后重新请求共2次", got)
+	}
+}
+
+func TestForget(t *testing.T) {
+	srv, calls := newTestServer(t, &Result{Active: true, Subject: "u1"})
+	c := newTestClient(t, srv.URL, Config{})
+
+	_, _ = c.Introspect(context.Background(), "token")
+	c.Forget("token")
+	_, _ = c.Introspect(context.Background(), "token")
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("内省接口调用%d次 期望2次", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	srv, _ := newTestServer(t, &Result{Active: false})
+	c := newTestClient(t, srv.URL, Config{})
+	if _, err := c.Validate(context.Background(), "token"); !errors.Is(err, ErrInactive) {
+		t.Fatalf("err=%v 期望ErrInactive", err)
+	}
+
+	bad, err := New(Config{Endpoint: srv.URL, ClientID: "client", ClientSecret: "wrong"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statusErr *StatusError
+	if _, err := bad.Validate(context.Background(), "token"); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("err=%v 期望401的StatusError", err)
+	}
+}
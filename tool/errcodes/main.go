@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"sass-scaffold/internal/common/reskit/codes"
+)
+
+// 导出错误码目录 供前端按错误码处理与展示
+//
+//	go run ./tool/errcodes -format markdown -o docs/errors.md
+//	go run ./tool/errcodes -format json
+//
+// 导出前先校验错误码 存在重复、越界或缺少翻译时退出码为1
+func main() {
+	var (
+		format = flag.String("format", "json", "输出格式 json/markdown")
+		output = flag.String("o", "", "输出文件 默认标准输出")
+	)
+	flag.Parse()
+
+	if err := codes.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("创建输出文件失败: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	catalog := buildCatalog()
+	var err error
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(catalog)
+	case "markdown", "md":
+		err = writeMarkdown(w, catalog)
+	default:
+		log.Fatalf("不支持的输出格式%s", *format)
+	}
+	if err != nil {
+		log.Fatalf("导出失败: %v", err)
+	}
+}
+
+type catalogEntry struct {
+	Code       int               `json:"code"`
+	Module     string            `json:"module"`
+	Type       codes.ErrorType   `json:"type"`
+	HTTPStatus int               `json:"http_status"`
+	Messages   map[string]string `json:"messages"`
+}
+
+type catalogModule struct {
+	Name string `json:"name"`
+	Min  int    `json:"min"`
+	Max  int    `json:"max"`
+}
+
+type catalog struct {
+	Languages []string        `json:"languages"`
+	Modules   []catalogModule `json:"modules"`
+	Codes     []catalogEntry  `json:"codes"`
+}
+
+func buildCatalog() catalog {
+	langs := codes.Languages()
+	sort.Strings(langs)
+
+	c := catalog{Languages: langs}
+	for _, m := range codes.Modules() {
+		c.Modules = append(c.Modules, catalogModule{Name: m.Name, Min: m.Min, Max: m.Max})
+	}
+	for _, e := range codes.Entries() {
+		messages := make(map[string]string, len(langs))
+		for _, lang := range langs {
+			// 保留{name}占位符 由前端按details填充
+			messages[lang] = codes.Localize(e.Code, lang, nil, e.Msg)
+		}
+		c.Codes = append(c.Codes, catalogEntry{
+			Code:       e.Code,
+			Module:     e.Module,
+			Type:       e.Type,
+			HTTPStatus: e.HTTPStatus(),
+			Messages:   messages,
+		})
+	}
+	return c
+}
+
+func writeMarkdown(w io.Writer, c catalog) error {
+	var sb strings.Builder
+	sb.WriteString("# 错误码\n\n")
+	sb.WriteString("由 `go run ./tool/errcodes -format markdown` 生成 请勿手动修改\n\n")
+
+	sb.WriteString("| 模块 | 区间 |\n| --- | --- |\n")
+	for _, m := range c.Modules {
+		fmt.Fprintf(&sb, "| %s | %d-%d |\n", m.Name, m.Min, m.Max)
+	}
+
+	sb.WriteString("\n| 错误码 | 模块 | 类型 | HTTP状态码 |")
+	for _, lang := range c.Languages {
+		fmt.Fprintf(&sb, " %s |", lang)
+	}
+	sb.WriteString("\n| --- | --- | --- | --- |" + strings.Repeat(" --- |", len(c.Languages)) + "\n")
+	for _, e := range c.Codes {
+		fmt.Fprintf(&sb, "| %d | %s | %s | %d |", e.Code, e.Module, e.Type, e.HTTPStatus)
+		for _, lang := range c.Languages {
+			fmt.Fprintf(&sb, " %s |", strings.ReplaceAll(e.Messages[lang], "|", `\|`))
+		}
+		sb.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
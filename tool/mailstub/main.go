@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// 本地邮件API桩服务 兼容email包的api投递后端
+//
+//	EMAIL_DRIVER=api EMAIL_API_URL=http://localhost:8025/send EMAIL_API_KEY=dev go run ./tool/mailstub
+//
+// 收件人包含bounce时 会向 -callback 回调一条退信事件 用于联调投递记录
+func main() {
+	var (
+		addr     = flag.String("addr", ":8025", "监听地址")
+		key      = flag.String("key", "dev", "API密钥 对应EMAIL_API_KEY")
+		callback = flag.String("callback", "", "回执回调地址 如http://localhost:8080/api/v1/email/events")
+		secret   = flag.String("secret", "", "回调共享密钥 对应EMAIL_WEBHOOK_SECRET")
+	)
+	flag.Parse()
+
+	var seq atomic.Int64
+
+	http.HandleFunc("POST /send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+*key {
+			http.Error(w, `{"error":"unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+
+		var req struct {
+			To      []string          `json:"to"`
+			Subject string            `json:"subject"`
+			Headers map[string]string `json:"headers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, `{"error":"invalid body"}`, http.StatusBadRequest)
+			return
+		}
+
+		id := fmt.Sprintf("stub-%d", seq.Add(1))
+		log.Printf("收到邮件 id=%s to=%v subject=%q", id, req.To, req.Subject)
+
+		if *callback != "" {
+			for _, to := range req.To {
+				if strings.Contains(to, "bounce") {
+					go sendBounce(*callback, *secret, req.Headers["Message-ID"], to)
+				}
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": id})
+	})
+
+	log.Printf("邮件API桩服务启动,地址:%s\n", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+func sendBounce(url, secret, messageID, to string) {
+	// 等待投递记录异步落库
+	time.Sleep(time.Second)
+
+	body, _ := json.Marshal(map[string]any{
+		"message_id":  messageID,
+		"type":        "bounced",
+		"reason":      "550 mailbox unavailable: " + to,
+		"occurred_at": time.Now(),
+	})
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("构造退信回调失败: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Email-Webhook-Token", secret)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("退信回调失败: %v", err)
+		return
+	}
+	_ = res.Body.Close()
+	log.Printf("退信回调完成 message_id=%s status=%d", messageID, res.StatusCode)
+}
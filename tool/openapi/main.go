@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gin-gonic/gin"
+
+	"sass-scaffold/internal/app"
+	"sass-scaffold/internal/common/middleware/auth"
+	"sass-scaffold/internal/common/openapi"
+)
+
+// 离线生成OpenAPI文档 不需要数据库与配置
+//
+//	go run ./tool/openapi              生成 api/openapi/openapi.json
+//	go run ./tool/openapi -check       文档与路由不一致或文件未更新时退出码为1 用于CI
+func main() {
+	var (
+		output = flag.String("o", "api/openapi/openapi.json", "输出文件")
+		check  = flag.Bool("check", false, "只检查 不写入文件")
+	)
+	flag.Parse()
+
+	data, drift, err := generate()
+	if err != nil {
+		log.Fatalf("生成文档失败: %v", err)
+	}
+	for _, d := range drift {
+		fmt.Fprintln(os.Stderr, d)
+	}
+
+	if *check {
+		old, err := os.ReadFile(*output)
+		if err != nil {
+			log.Fatalf("读取%s失败: %v", *output, err)
+		}
+		if !bytes.Equal(old, data) {
+			fmt.Fprintf(os.Stderr, "%s已过期 请执行 go run ./tool/openapi\n", *output)
+			os.Exit(1)
+		}
+		if len(drift) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		log.Fatalf("写入%s失败: %v", *output, err)
+	}
+	if len(drift) > 0 {
+		os.Exit(1)
+	}
+}
+
+// generate 生成格式化后的文档 drift为路由与文档不一致之处
+func generate() (data []byte, drift []string, err error) {
+	doc, drift := openapi.Build(routes())
+	data, err = json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(data, '\n'), drift, nil
+}
+
+// routes 与mian.go使用同一注册函数 handler只用于注册 不会被调用
+func routes() gin.RoutesInfo {
+	gin.SetMode(gin.ReleaseMode)
+	engine := gin.New()
+	r := engine.Group(openapi.PathPrefix)
+
+	// 中间件只用于注册路由 无需令牌校验
+	app.RegisterRoutes(r, auth.NewMiddleware(nil), app.RouteOnlyModules())
+	return engine.Routes()
+}
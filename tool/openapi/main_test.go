@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// 新增路由未编写文档、文档中的路由已删除或未重新生成openapi.json时失败
+func TestDocumentUpToDate(t *testing.T) {
+	data, drift, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range drift {
+		t.Error(d)
+	}
+
+	old, err := os.ReadFile("../../api/openapi/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(old, data) {
+		t.Error("api/openapi/openapi.json已过期 请执行 go run ./tool/openapi")
+	}
+}